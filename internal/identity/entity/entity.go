@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/mitchellh/mapstructure"
@@ -103,6 +104,42 @@ func FindAliases(client *api.Client, params *FindAliasParams) ([]*Alias, error)
 	return result, err
 }
 
+// FindAliasIDs lists all entity aliases and returns the IDs of those matching
+// the given FindAliasParams.
+func FindAliasIDs(client *api.Client, params *FindAliasParams) ([]string, error) {
+	resp, err := client.Logical().List(RootAliasIDPath)
+	if resp == nil || err != nil {
+		return nil, err
+	}
+
+	keyInfo, ok := resp.Data["key_info"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var result []string
+	for id, v := range keyInfo {
+		var a Alias
+		if err := mapstructure.Decode(v, &a); err != nil {
+			return nil, err
+		}
+
+		if params.Name != "" && a.Name != params.Name {
+			continue
+		}
+
+		if params.MountAccessor != "" && a.MountAccessor != params.MountAccessor {
+			continue
+		}
+
+		result = append(result, id)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
 // JoinAliasID to the root alias ID path.
 func JoinAliasID(id string) string {
 	return fmt.Sprintf("%s/%s", RootAliasIDPath, id)
@@ -279,3 +279,128 @@ func TestFindAliases(t *testing.T) {
 		})
 	}
 }
+
+type testFindAliasIDsHandler struct {
+	wantErrOnList bool
+	aliases       map[string]*Alias
+}
+
+func (t *testFindAliasIDsHandler) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1"+RootAliasIDPath || req.URL.Query().Get("list") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if t.wantErrOnList {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var keys []interface{}
+		keyInfo := map[string]interface{}{}
+		for id, a := range t.aliases {
+			keys = append(keys, id)
+			keyInfo[id] = a
+		}
+
+		m, err := json.Marshal(
+			&api.Secret{
+				Data: map[string]interface{}{
+					"keys":     keys,
+					"key_info": keyInfo,
+				},
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(m)
+	}
+}
+
+func TestFindAliasIDs(t *testing.T) {
+	t.Parallel()
+
+	aliases := map[string]*Alias{
+		"A1": {
+			Name:          "bob",
+			MountAccessor: "CC417368-0C63-407A-93AD-2D76A72F58E2",
+		},
+		"A2": {
+			Name:          "alice",
+			MountAccessor: "CC417368-0C63-407A-93AD-2D76A72F58E2",
+		},
+		"A3": {
+			Name:          "bob",
+			MountAccessor: "CC417368-0C63-407A-93AD-2D76A72F58E3",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		params  *FindAliasParams
+		handler *testFindAliasIDsHandler
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			params:  &FindAliasParams{},
+			handler: &testFindAliasIDsHandler{},
+			want:    nil,
+		},
+		{
+			name:    "all",
+			params:  &FindAliasParams{},
+			handler: &testFindAliasIDsHandler{aliases: aliases},
+			want:    []string{"A1", "A2", "A3"},
+		},
+		{
+			name:    "name-only",
+			params:  &FindAliasParams{Name: "bob"},
+			handler: &testFindAliasIDsHandler{aliases: aliases},
+			want:    []string{"A1", "A3"},
+		},
+		{
+			name: "name-and-mount-accessor",
+			params: &FindAliasParams{
+				Name:          "bob",
+				MountAccessor: "CC417368-0C63-407A-93AD-2D76A72F58E3",
+			},
+			handler: &testFindAliasIDsHandler{aliases: aliases},
+			want:    []string{"A3"},
+		},
+		{
+			name:    "error-on-list",
+			params:  &FindAliasParams{},
+			handler: &testFindAliasIDsHandler{wantErrOnList: true},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, ln := testutil.TestHTTPServer(t, tt.handler.handler())
+			defer ln.Close()
+
+			config.Address = fmt.Sprintf("http://%s", ln.Addr())
+			c, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := FindAliasIDs(c, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindAliasIDs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAliasIDs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package group
+
+import (
+	"sort"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	RootGroupPath   = "/identity/group"
+	RootAliasPath   = RootGroupPath + "-alias"
+	RootAliasIDPath = RootAliasPath + "/id"
+)
+
+// Alias represents a Vault identity group alias.
+type Alias struct {
+	CanonicalId    string      `mapstructure:"canonical_id" json:"canonical_id,omitempty"`
+	CreationTime   string      `mapstructure:"creation_time" json:"creation_time,omitempty"`
+	ID             string      `mapstructure:"id" json:"id,omitempty"`
+	LastUpdateTime string      `mapstructure:"last_update_time" json:"last_update_time,omitempty"`
+	Metadata       interface{} `mapstructure:"metadata" json:"metadata,omitempty"`
+	MountAccessor  string      `mapstructure:"mount_accessor" json:"mount_accessor,omitempty"`
+	MountPath      string      `mapstructure:"mount_path" json:"mount_path,omitempty"`
+	MountType      string      `mapstructure:"mount_type" json:"mount_type,omitempty"`
+	Name           string      `mapstructure:"name" json:"name,omitempty"`
+}
+
+// FindAliasParams
+type FindAliasParams struct {
+	// Name to constrain the search to.
+	Name string
+	// MountAccessor to constrain the search to.
+	MountAccessor string
+	// CanonicalID of the group to constrain the search to.
+	CanonicalID string
+}
+
+// FindAliasIDs lists all group aliases and returns the IDs of those matching
+// the given FindAliasParams.
+func FindAliasIDs(client *api.Client, params *FindAliasParams) ([]string, error) {
+	resp, err := client.Logical().List(RootAliasIDPath)
+	if resp == nil || err != nil {
+		return nil, err
+	}
+
+	keyInfo, ok := resp.Data["key_info"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var result []string
+	for id, v := range keyInfo {
+		var a Alias
+		if err := mapstructure.Decode(v, &a); err != nil {
+			return nil, err
+		}
+
+		if params.Name != "" && a.Name != params.Name {
+			continue
+		}
+
+		if params.MountAccessor != "" && a.MountAccessor != params.MountAccessor {
+			continue
+		}
+
+		if params.CanonicalID != "" && a.CanonicalId != params.CanonicalID {
+			continue
+		}
+
+		result = append(result, id)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
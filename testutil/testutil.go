@@ -151,11 +151,21 @@ func GetTestADCreds(t *testing.T) (string, string, string) {
 	return v[0], v[1], v[2]
 }
 
+func GetTestLDAPCreds(t *testing.T) (string, string, string) {
+	v := SkipTestEnvUnset(t, "LDAP_BINDDN", "LDAP_BINDPASS", "LDAP_URL")
+	return v[0], v[1], v[2]
+}
+
 func GetTestNomadCreds(t *testing.T) (string, string) {
 	v := SkipTestEnvUnset(t, "NOMAD_ADDR", "NOMAD_TOKEN")
 	return v[0], v[1]
 }
 
+func GetTestConsulCreds(t *testing.T) (string, string) {
+	v := SkipTestEnvUnset(t, "CONSUL_HTTP_ADDR", "CONSUL_HTTP_TOKEN")
+	return v[0], v[1]
+}
+
 func TestCheckResourceAttrJSON(name, key, expectedValue string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState, ok := s.RootModule().Resources[name]
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -41,6 +42,7 @@ type addTokenFieldsConfig struct {
 	TokenTTLConflict            []string
 
 	TokenTypeDefault string
+	TokenTypeValues  []string
 }
 
 // Common field schemas for Auth Backends
@@ -48,6 +50,9 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	if config.TokenTypeDefault == "" {
 		config.TokenTypeDefault = "default"
 	}
+	if len(config.TokenTypeValues) == 0 {
+		config.TokenTypeValues = []string{"service", "batch", "default"}
+	}
 
 	fields[TokenFieldBoundCIDRs] = &schema.Schema{
 		Type: schema.TypeSet,
@@ -96,10 +101,11 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	}
 
 	fields[TokenFieldType] = &schema.Schema{
-		Type:        schema.TypeString,
-		Description: "The type of token to generate, service or batch",
-		Optional:    true,
-		Default:     config.TokenTypeDefault,
+		Type:         schema.TypeString,
+		Description:  "The type of token to generate, service or batch",
+		Optional:     true,
+		Default:      config.TokenTypeDefault,
+		ValidateFunc: validation.StringInSlice(config.TokenTypeValues, false),
 	}
 
 	fields[TokenFieldTTL] = &schema.Schema{
@@ -114,6 +120,7 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 		Description:   "The maximum number of times a token may be used, a value of zero means unlimited",
 		Optional:      true,
 		ConflictsWith: config.TokenNumUsesConflict,
+		ValidateFunc:  validation.IntAtLeast(0),
 	}
 }
 
@@ -0,0 +1,41 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const fieldNamespace = "namespace"
+
+// namespaceSchema returns the schema for the optional per-resource namespace
+// override.
+func namespaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Description: "Target namespace for this resource. " +
+			"Defaults to the provider's namespace. (requires Enterprise)",
+	}
+}
+
+// getClient returns the provider's client, or a clone of it scoped to the
+// resource's namespace if one is set.
+func getClient(d *schema.ResourceData, meta interface{}) (*api.Client, error) {
+	client := meta.(*api.Client)
+
+	ns, ok := d.GetOk(fieldNamespace)
+	if !ok {
+		return client, nil
+	}
+
+	c, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %w", err)
+	}
+	c.SetNamespace(ns.(string))
+
+	return c, nil
+}
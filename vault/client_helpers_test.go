@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+func TestGetClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		parentNS  string
+		expectNS  string
+		expectNew bool
+	}{
+		{
+			name:     "provider-namespace",
+			raw:      map[string]interface{}{"name": "foo", "policy": "bar"},
+			parentNS: "ns1",
+			expectNS: "ns1",
+		},
+		{
+			name:      "resource-namespace",
+			raw:       map[string]interface{}{"name": "foo", "policy": "bar", fieldNamespace: "ns2"},
+			parentNS:  "ns1",
+			expectNS:  "ns2",
+			expectNew: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := api.DefaultConfig()
+			config.CloneHeaders = true
+			config.CloneToken = true
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.SetNamespace(tt.parentNS)
+
+			d := schema.TestResourceDataRaw(t, policyResource().Schema, tt.raw)
+			actual, err := getClient(d, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.expectNew == (actual == client) {
+				t.Errorf("expected new client %t", tt.expectNew)
+			}
+
+			if got := actual.Headers().Get(consts.NamespaceHeaderName); got != tt.expectNS {
+				t.Errorf("expected namespace %q, actual %q", tt.expectNS, got)
+			}
+
+			// the provider's client must never be modified
+			if got := client.Headers().Get(consts.NamespaceHeaderName); got != tt.parentNS {
+				t.Errorf("expected provider namespace %q, actual %q", tt.parentNS, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
+)
+
+var identityEntityAliasDataSourceFields = []string{
+	"canonical_id",
+	"creation_time",
+	"custom_metadata",
+	"last_update_time",
+	"local",
+	"mount_path",
+	"mount_type",
+}
+
+func identityEntityAliasDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityEntityAliasDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the entity alias.",
+			},
+			"mount_accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Accessor of the mount to which the alias belongs to.",
+			},
+			"canonical_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the entity to which this is an alias.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Custom metadata associated with this alias.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mount_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mount_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func identityEntityAliasDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	mountAccessor := d.Get("mount_accessor").(string)
+
+	id, err := identityEntityAliasLookupID(client, name, mountAccessor)
+	if err != nil {
+		return err
+	}
+
+	path := entity.JoinAliasID(id)
+	log.Printf("[DEBUG] Reading entity alias %q from %q", id, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading entity alias %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read entity alias %q", id)
+
+	if resp == nil {
+		return fmt.Errorf("no entity alias found at %q", path)
+	}
+
+	d.SetId(id)
+	for _, k := range identityEntityAliasDataSourceFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on entity alias %q: %s", k, id, err)
+			}
+		}
+	}
+
+	return nil
+}
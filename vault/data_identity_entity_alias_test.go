@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceIdentityEntityAlias_nameAndMountAccessor(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	dataSourceName := "data.vault_identity_entity_alias.test"
+	resourceName := "vault_identity_entity_alias.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityEntityAlias_config(entity, "vault_identity_entity_alias.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "canonical_id", resourceName, "canonical_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_accessor", resourceName, "mount_accessor"),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_type", "github"),
+					resource.TestCheckResourceAttr(dataSourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "custom_metadata.version", "1"),
+				),
+			},
+			{
+				Config:      testDataSourceIdentityEntityAlias_config(entity, `"does-not-exist"`),
+				ExpectError: regexp.MustCompile(`no entity alias "does-not-exist" found`),
+			},
+		},
+	})
+}
+
+func testDataSourceIdentityEntityAlias_config(entityName, aliasName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "test" {
+  name     = "%s"
+  policies = ["test"]
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_entity_alias" "test" {
+  name           = vault_identity_entity.test.name
+  mount_accessor = vault_auth_backend.github.accessor
+  canonical_id   = vault_identity_entity.test.id
+  custom_metadata = {
+    version = "1"
+  }
+}
+
+data "vault_identity_entity_alias" "test" {
+  name           = %s
+  mount_accessor = vault_identity_entity_alias.test.mount_accessor
+}
+`, entityName, entityName, aliasName)
+}
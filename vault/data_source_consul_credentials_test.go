@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceConsulSecretBackendCredentials(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-consul")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	address, token := testutil.GetTestConsulCreds(t)
+
+	dataSourceName := "data.vault_consul_secret_backend_credentials.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConsulSecretBackendCredentialsConfig(backend, address, token, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "backend", backend),
+					resource.TestCheckResourceAttr(dataSourceName, "role", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accessor"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_duration"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_start_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_renewable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConsulSecretBackendCredentialsConfig(backend, address, token, name string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path        = "%s"
+  description = "test description"
+  address     = "%s"
+  token       = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend  = vault_consul_secret_backend.test.path
+  name     = "%s"
+  policies = ["global-management"]
+}
+
+data "vault_consul_secret_backend_credentials" "test" {
+  backend = vault_consul_secret_backend.test.path
+  role    = vault_consul_secret_backend_role.test.name
+}
+`, backend, address, token, name)
+}
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretImpersonatedAccountTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpSecretImpersonatedAccountTokenDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP secret backend to generate tokens from.",
+			},
+			"impersonated_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the impersonated account.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth2 access token read from Vault.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Unix timestamp in seconds at which the token expires.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lifetime of the token in seconds.",
+			},
+		},
+	}
+}
+
+func gcpSecretImpersonatedAccountTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	impersonatedAccount := d.Get("impersonated_account").(string)
+	path := gcpSecretImpersonatedAccountPath(backend, impersonatedAccount) + "/token"
+
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no impersonated account found at %q", path)
+	}
+
+	token, ok := secret.Data["token"].(string)
+	if !ok || token == "" {
+		return fmt.Errorf("token is not set in response")
+	}
+
+	d.SetId(path)
+	if err := d.Set("token", token); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"expires_at_seconds", "token_ttl"} {
+		v, ok := secret.Data[k].(json.Number)
+		if !ok {
+			continue
+		}
+		i, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected %s %q to be a number, and it isn't", k, v)
+		}
+		if err := d.Set(k, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
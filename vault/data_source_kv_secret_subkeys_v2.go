@@ -0,0 +1,134 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretSubkeysV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretSubkeysV2DataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the secret to retrieve. Defaults to the latest version.",
+			},
+			"depth": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Specifies the deepest nesting level to provide in the output. " +
+					"If non-zero, keys that reside at the specified depth value will be " +
+					"artificially treated as leaves and will thus be 'null' even if further " +
+					"underlying sub-keys exist.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret subkeys are read from.",
+			},
+			"subkeys_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded subkeys structure read from Vault.",
+			},
+			"subkeys": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: "Map of the top-level keys of the secret. Leaf keys have an empty " +
+					"value, nested keys have their JSON-encoded subkeys structure as value.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kvSecretSubkeysV2DataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+	path := getKVV2Path(mount, name, "subkeys")
+
+	data := map[string][]string{}
+	if v, ok := d.GetOk("version"); ok {
+		data["version"] = []string{strconv.Itoa(v.(int))}
+	}
+	if v, ok := d.GetOk("depth"); ok {
+		data["depth"] = []string{strconv.Itoa(v.(int))}
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret subkeys %q from Vault", path)
+	secret, err := client.Logical().ReadWithData(path, data)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no secret found at %q", path)
+	}
+
+	d.SetId(path)
+	if err := d.Set("path", path); err != nil {
+		return err
+	}
+
+	subkeys, _ := secret.Data["subkeys"].(map[string]interface{})
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonSubkeysBytes, _ := json.Marshal(subkeys)
+	if err := d.Set("subkeys_json", string(jsonSubkeysBytes)); err != nil {
+		return err
+	}
+
+	subkeysMap := map[string]string{}
+	for k, v := range subkeys {
+		if v == nil {
+			subkeysMap[k] = ""
+		} else {
+			vBytes, _ := json.Marshal(v)
+			subkeysMap[k] = string(vBytes)
+		}
+	}
+	if err := d.Set("subkeys", subkeysMap); err != nil {
+		return err
+	}
+
+	if v, ok := secret.Data["metadata"].(map[string]interface{}); ok {
+		if v, ok := v["version"].(json.Number); ok {
+			version, err := v.Int64()
+			if err != nil {
+				return err
+			}
+			if err := d.Set("version", int(version)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSubkeysV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+
+	full := "data.vault_kv_secret_subkeys_v2.full"
+	shallow := "data.vault_kv_secret_subkeys_v2.shallow"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSubkeysV2Config(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(full, "path", fmt.Sprintf("%s/subkeys/%s", mount, name)),
+					resource.TestCheckResourceAttr(full, "version", "1"),
+					resource.TestCheckResourceAttr(full, "subkeys.%", "2"),
+					resource.TestCheckResourceAttr(full, "subkeys.zip", ""),
+					resource.TestCheckResourceAttr(full, "subkeys.foo", `{"bar":null}`),
+					resource.TestCheckResourceAttr(full, "subkeys_json", `{"foo":{"bar":null},"zip":null}`),
+					resource.TestCheckResourceAttr(shallow, "subkeys.foo", ""),
+					resource.TestCheckResourceAttr(shallow, "subkeys_json", `{"foo":null,"zip":null}`),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSubkeysV2Config(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode({
+    zip = "zap"
+    foo = {
+      bar = "baz"
+    }
+  })
+}
+
+data "vault_kv_secret_subkeys_v2" "full" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.test.name
+}
+
+data "vault_kv_secret_subkeys_v2" "shallow" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.test.name
+  depth = 1
+}
+`, mount, name)
+}
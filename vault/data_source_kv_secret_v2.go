@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretV2DataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the secret to retrieve. Defaults to the latest version.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret is written.",
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded secret data read from Vault.",
+				Sensitive:   true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the secret was created.",
+			},
+			"deletion_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deletion time for the secret.",
+			},
+			"destroyed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the secret has been destroyed.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Custom metadata for the secret.",
+			},
+		},
+	}
+}
+
+func kvSecretV2DataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+	path := getKVV2Path(mount, name, "data")
+
+	var data map[string][]string
+	if v, ok := d.GetOk("version"); ok {
+		data = map[string][]string{
+			"version": {strconv.Itoa(v.(int))},
+		}
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret %q from Vault", path)
+	secret, err := client.Logical().ReadWithData(path, data)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no secret found at %q", path)
+	}
+
+	d.SetId(path)
+	if err := d.Set("path", path); err != nil {
+		return err
+	}
+
+	secretData, _ := secret.Data["data"].(map[string]interface{})
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonDataBytes, _ := json.Marshal(secretData)
+	if err := d.Set("data_json", string(jsonDataBytes)); err != nil {
+		return err
+	}
+
+	dataMap := map[string]string{}
+	for k, v := range secretData {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	if err := d.Set("data", dataMap); err != nil {
+		return err
+	}
+
+	if v, ok := secret.Data["metadata"].(map[string]interface{}); ok {
+		if err := setKVV2SecretMetadata(d, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setKVV2SecretMetadata sets the per-version metadata returned alongside a
+// KV-V2 secret's data.
+func setKVV2SecretMetadata(d *schema.ResourceData, metadata map[string]interface{}) error {
+	for _, k := range []string{"created_time", "deletion_time", "destroyed", "custom_metadata"} {
+		if err := d.Set(k, metadata[k]); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := metadata["version"].(json.Number); ok {
+		version, err := v.Int64()
+		if err != nil {
+			return err
+		}
+		if err := d.Set("version", int(version)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
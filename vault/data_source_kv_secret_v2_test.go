@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+
+	latest := "data.vault_kv_secret_v2.latest"
+	pinned := "data.vault_kv_secret_v2.pinned"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVV2Config(mount, name, "zap", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(latest, "mount", mount),
+					resource.TestCheckResourceAttr(latest, "name", name),
+					resource.TestCheckResourceAttr(latest, "path", fmt.Sprintf("%s/data/%s", mount, name)),
+					resource.TestCheckResourceAttr(latest, "version", "1"),
+					resource.TestCheckResourceAttr(latest, "destroyed", "false"),
+					resource.TestCheckResourceAttr(latest, "deletion_time", ""),
+					resource.TestCheckResourceAttrSet(latest, "created_time"),
+					resource.TestCheckResourceAttr(latest, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(latest, "data_json", `{"zip":"zap"}`),
+					resource.TestCheckResourceAttr(pinned, "version", "1"),
+					resource.TestCheckResourceAttr(pinned, "data.zip", "zap"),
+				),
+			},
+			{
+				Config: testDataSourceKVV2Config(mount, name, "zoop", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(latest, "version", "2"),
+					resource.TestCheckResourceAttr(latest, "data.zip", "zoop"),
+					resource.TestCheckResourceAttr(pinned, "version", "1"),
+					resource.TestCheckResourceAttr(pinned, "data.zip", "zap"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVV2Config(mount, name, value string, version int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path        = "%s"
+  type        = "kv"
+  options     = { version = "2" }
+  description = "KV Version 2 secret engine mount"
+}
+
+resource "vault_generic_secret" "test" {
+  path      = "${vault_mount.kvv2.path}/%s"
+  data_json = jsonencode({
+    zip = "%s"
+  })
+}
+
+data "vault_kv_secret_v2" "latest" {
+  mount      = vault_mount.kvv2.path
+  name       = "%s"
+  depends_on = [vault_generic_secret.test]
+}
+
+data "vault_kv_secret_v2" "pinned" {
+  mount      = vault_mount.kvv2.path
+  name       = "%s"
+  version    = %d
+  depends_on = [vault_generic_secret.test]
+}
+`, mount, name, value, name, name, version)
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretListDataSourceV2() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretListDataSourceV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Full name of the secret folder to list. For a nested folder, " +
+					"the name is the nested path excluding the mount and metadata " +
+					"prefix. Defaults to the root of the mount.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secrets are listed.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all secret names. Folders are suffixed with '/'.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kvSecretListDataSourceV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+	path := getKVV2Path(mount, name, "metadata")
+
+	log.Printf("[DEBUG] Listing KV-V2 secrets at %q from Vault", path)
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing from Vault: %s", err)
+	}
+
+	names := []interface{}{}
+	if secret != nil {
+		if v, ok := secret.Data["keys"].([]interface{}); ok {
+			names = v
+		}
+	}
+
+	d.SetId(path)
+	if err := d.Set("path", path); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVV2List(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	folder := acctest.RandomWithPrefix("foo")
+
+	root := "data.vault_kv_secrets_list_v2.root"
+	nested := "data.vault_kv_secrets_list_v2.nested"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVV2ListConfig(mount, folder),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(root, "mount", mount),
+					resource.TestCheckResourceAttr(root, "path", fmt.Sprintf("%s/metadata/", mount)),
+					resource.TestCheckResourceAttr(root, "names.#", "2"),
+					resource.TestCheckResourceAttr(root, "names.0", "bar"),
+					resource.TestCheckResourceAttr(root, "names.1", folder+"/"),
+					resource.TestCheckResourceAttr(nested, "name", folder),
+					resource.TestCheckResourceAttr(nested, "path", fmt.Sprintf("%s/metadata/%s", mount, folder)),
+					resource.TestCheckResourceAttr(nested, "names.#", "2"),
+					resource.TestCheckResourceAttr(nested, "names.0", "baz"),
+					resource.TestCheckResourceAttr(nested, "names.1", "qux"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVV2ListConfig(mount, folder string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path        = "%s"
+  type        = "kv"
+  options     = { version = "2" }
+  description = "KV Version 2 secret engine mount"
+}
+
+resource "vault_generic_secret" "test" {
+  for_each  = toset(["bar", "%s/baz", "%s/qux"])
+  path      = "${vault_mount.kvv2.path}/${each.key}"
+  data_json = jsonencode({
+    zip = "zap"
+  })
+}
+
+data "vault_kv_secrets_list_v2" "root" {
+  mount      = vault_mount.kvv2.path
+  depends_on = [vault_generic_secret.test]
+}
+
+data "vault_kv_secrets_list_v2" "nested" {
+  mount      = vault_mount.kvv2.path
+  name       = "%s"
+  depends_on = [vault_generic_secret.test]
+}
+`, mount, folder, folder, folder)
+}
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapStaticCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ldapStaticCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ldap",
+				Description: "LDAP Secret Backend to read credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the static role.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Distinguished name of the LDAP entry.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Username of the LDAP entry.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Current password of the LDAP entry.",
+			},
+			"last_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Previous password of the LDAP entry.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last password rotation by Vault.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Seconds until the next password rotation.",
+			},
+		},
+	}
+}
+
+func ldapStaticCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := fmt.Sprintf("%s/static-cred/%s", backend, role)
+
+	// Reading the static credential returns the current password, it does
+	// not trigger a rotation.
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no static role found at %q", path)
+	}
+
+	password, ok := secret.Data["password"].(string)
+	if !ok || password == "" {
+		return fmt.Errorf("password is not set in response")
+	}
+
+	d.SetId(path)
+	if err := d.Set("password", password); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"dn", "username", "last_password", "last_vault_rotation", "ttl"} {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceLDAPStaticCredentials(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	dataName := "data.vault_ldap_static_credentials.creds"
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+	v := testutil.SkipTestEnvUnset(t, "LDAP_STATIC_USERNAME", "LDAP_STATIC_DN")
+	username, dn := v[0], v[1]
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLDAPStaticCredentials_config(backend, bindDN, bindPass, url, username, dn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "username", username),
+					resource.TestCheckResourceAttr(dataName, "dn", dn),
+					resource.TestCheckResourceAttrSet(dataName, "password"),
+					resource.TestCheckResourceAttrSet(dataName, "last_vault_rotation"),
+					resource.TestCheckResourceAttrSet(dataName, "ttl"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLDAPStaticCredentials_config(backend, bindDN, bindPass, url, username, dn string) string {
+	return testLDAPSecretBackendStaticRole_config(backend, bindDN, bindPass, url, username, dn, 3600) + `
+data "vault_ldap_static_credentials" "creds" {
+  backend = vault_ldap_secret_backend_static_role.test.backend
+  role    = vault_ldap_secret_backend_static_role.test.role_name
+}
+`
+}
@@ -0,0 +1,125 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func namespaceDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: namespaceDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Path of the namespace.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"namespace_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the namespace.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Custom metadata describing the namespace.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			fieldNamespace: namespaceSchema(),
+		},
+	}
+}
+
+func namespaceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Reading namespace %q from Vault", path)
+	resp, err := client.Logical().Read("sys/namespaces/" + path)
+	if err != nil {
+		return fmt.Errorf("error reading namespace %q from Vault: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read namespace %q from Vault", path)
+
+	if resp == nil {
+		return fmt.Errorf("namespace %q not found", path)
+	}
+
+	d.SetId(path)
+
+	if err := d.Set("namespace_id", resp.Data["id"]); err != nil {
+		return err
+	}
+
+	if err := d.Set("custom_metadata", resp.Data["custom_metadata"]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func namespacesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: namespacesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"paths": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Paths of the child namespaces.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			fieldNamespace: namespaceSchema(),
+		},
+	}
+}
+
+func namespacesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	path := "sys/namespaces"
+
+	log.Printf("[DEBUG] Listing namespaces from Vault")
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing namespaces from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Listed namespaces from Vault")
+
+	paths := make([]string, 0)
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				paths = append(paths, strings.TrimSuffix(k.(string), "/"))
+			}
+		}
+	}
+
+	if ns, ok := d.GetOk(fieldNamespace); ok {
+		d.SetId(strings.Trim(ns.(string), "/") + "/" + path)
+	} else {
+		d.SetId(path)
+	}
+
+	if err := d.Set("paths", paths); err != nil {
+		return err
+	}
+
+	return nil
+}
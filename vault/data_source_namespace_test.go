@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceNamespace(t *testing.T) {
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceNamespaceConfig(namespacePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_namespace.test", "path", namespacePath),
+					resource.TestCheckResourceAttrPair("data.vault_namespace.test", "namespace_id",
+						"vault_namespace.test", "namespace_id"),
+					resource.TestCheckTypeSetElemAttr("data.vault_namespaces.test", "paths.*", namespacePath),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceNamespaceConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = %q
+}
+
+data "vault_namespace" "test" {
+  path = vault_namespace.test.path
+}
+
+data "vault_namespaces" "test" {
+  depends_on = [vault_namespace.test]
+}
+`, path)
+}
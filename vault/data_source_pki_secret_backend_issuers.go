@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendIssuersDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendIssuersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Keys used by issuers under the backend path.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_info": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of issuer IDs to issuer names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuersDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := backend + "/issuers"
+
+	log.Printf("[DEBUG] Listing PKI secret backend issuers at %q", path)
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing issuers at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed PKI secret backend issuers at %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no issuers found at %q", path)
+	}
+
+	d.SetId(path)
+
+	if err := d.Set("keys", secret.Data["keys"]); err != nil {
+		return err
+	}
+
+	keyInfo := map[string]string{}
+	if v, ok := secret.Data["key_info"].(map[string]interface{}); ok {
+		for id, info := range v {
+			name := ""
+			if m, ok := info.(map[string]interface{}); ok {
+				if n, ok := m["issuer_name"].(string); ok {
+					name = n
+				}
+			}
+			keyInfo[id] = name
+		}
+	}
+
+	if err := d.Set("key_info", keyInfo); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendIssuers_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	dataName := "data.vault_pki_secret_backend_issuers.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPKISecretBackendIssuersDataSource_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "backend", backend),
+					resource.TestCheckResourceAttr(dataName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "key_info.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testPKISecretBackendIssuersDataSource_basic(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "PKI secret engine mount"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test"
+  ttl         = "86400"
+}
+
+data "vault_pki_secret_backend_issuers" "test" {
+  backend    = vault_pki_secret_backend_root_cert.test.backend
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}
+`, path)
+}
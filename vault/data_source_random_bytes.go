@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func randomBytesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: randomBytesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      32,
+				Description:  "Number of random bytes to generate.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "base64",
+				Description:  "Output encoding of the random bytes; one of 'base64' or 'hex'.",
+				ValidateFunc: validation.StringInSlice([]string{"base64", "hex"}, false),
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a Transit secret backend to generate the random bytes with. Uses sys/tools if unset.",
+			},
+			"random_bytes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The random bytes returned from Vault, in the requested format.",
+			},
+		},
+	}
+}
+
+func randomBytesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := "sys/tools/random/"
+	if v, ok := d.GetOk("backend"); ok {
+		path = strings.Trim(v.(string), "/") + "/random/"
+	}
+	path += strconv.Itoa(d.Get("bytes").(int))
+
+	data := map[string]interface{}{
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Generating random bytes from %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating random bytes from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated random bytes from %q", path)
+
+	if resp == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	randomBytes, ok := resp.Data["random_bytes"].(string)
+	if !ok || randomBytes == "" {
+		return fmt.Errorf("random_bytes is not set in response")
+	}
+
+	d.SetId(path)
+	d.Set("random_bytes", randomBytes)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceRandomBytes(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceRandomBytes_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_random_bytes.sys", "random_bytes",
+						regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`)),
+					resource.TestMatchResourceAttr("data.vault_random_bytes.transit", "random_bytes",
+						regexp.MustCompile(`^[0-9a-f]{32}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceRandomBytes_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+data "vault_random_bytes" "sys" {}
+
+data "vault_random_bytes" "transit" {
+  backend = vault_mount.test.path
+  bytes   = 16
+  format  = "hex"
+}
+`, backend)
+}
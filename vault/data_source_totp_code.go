@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path the TOTP secret backend is mounted at.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to generate a code for.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current TOTP code of the key.",
+			},
+		},
+	}
+}
+
+func totpCodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("backend").(string) + "/code/" + d.Get("name").(string)
+
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	if secret == nil {
+		return fmt.Errorf("no key found at %q", path)
+	}
+
+	code, ok := secret.Data["code"].(string)
+	if !ok || code == "" {
+		return fmt.Errorf("code is not set in response")
+	}
+
+	d.SetId(path)
+	d.Set("code", code)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTOTPCode(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTOTPCode_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_totp_code.test", "code", regexp.MustCompile(`^\d{6}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTOTPCode_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = vault_mount.totp.path
+  name    = "test"
+  url     = "otpauth://totp/Example:alice?secret=JBSWY3DPEHPK3PXP&issuer=Example"
+}
+
+data "vault_totp_code" "test" {
+  backend = vault_mount.totp.path
+  name    = vault_totp_secret_backend_key.test.name
+}
+`, backend)
+}
@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var transitCMACKeyTypes = []string{"aes128-cmac", "aes192-cmac", "aes256-cmac"}
+
+func transitCMACDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitCMACDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to use. Must be of type aes128-cmac, aes192-cmac or aes256-cmac.",
+			},
+			"input": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Base64 encoded input data.",
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"mac_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The MAC length to use, in bytes.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for generating the CMAC.",
+			},
+			"cmac": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CMAC returned from Vault.",
+			},
+		},
+	}
+}
+
+func transitCMACDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	keyPath := backend + "/keys/" + key
+
+	// Vault's error for a key that does not support CMAC is not very telling,
+	// so check the key type first.
+	log.Printf("[DEBUG] Reading transit key %q", keyPath)
+	keyResp, err := client.Logical().Read(keyPath)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", keyPath, err)
+	}
+	if keyResp == nil {
+		return fmt.Errorf("transit key %q not found", keyPath)
+	}
+	keyType, _ := keyResp.Data["type"].(string)
+	supported := false
+	for _, t := range transitCMACKeyTypes {
+		if keyType == t {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("transit key %q of type %q does not support CMAC, expected one of %s",
+			keyPath, keyType, strings.Join(transitCMACKeyTypes, ", "))
+	}
+
+	data := map[string]interface{}{
+		"input": d.Get("input").(string),
+	}
+	for _, k := range []string{"mac_length", "key_version"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	path := backend + "/cmac/" + key
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("issue generating CMAC with key: %s", err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	cmac, ok := resp.Data["cmac"].(string)
+	if !ok || cmac == "" {
+		return fmt.Errorf("cmac is not set in response")
+	}
+
+	d.SetId(cmac)
+	d.Set("cmac", cmac)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitCMAC(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitCMAC_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_cmac.test", "cmac", regexp.MustCompile("^vault:v1:")),
+					// the CMAC is deterministic for the same key and input
+					resource.TestCheckResourceAttrPair(
+						"data.vault_transit_cmac.test", "cmac",
+						"data.vault_transit_cmac.again", "cmac"),
+				),
+			},
+			{
+				Config:      testDataSourceTransitCMAC_unsupportedConfig(backend),
+				ExpectError: regexp.MustCompile(`does not support CMAC`),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitCMAC_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  type             = "aes256-cmac"
+  deletion_allowed = true
+}
+
+data "vault_transit_cmac" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  input   = base64encode("foo")
+}
+
+data "vault_transit_cmac" "again" {
+  backend     = vault_mount.test.path
+  key         = vault_transit_secret_backend_key.test.name
+  input       = base64encode("foo")
+  key_version = 1
+}
+`, backend)
+}
+
+func testDataSourceTransitCMAC_unsupportedConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_cmac" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  input   = base64encode("foo")
+}
+`, backend)
+}
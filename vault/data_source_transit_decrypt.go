@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
@@ -55,12 +56,25 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"context":    context,
 	}
 
-	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
+	path := backend + "/decrypt/" + key
+	decryptedData, err := client.Logical().Write(path, payload)
 	if err != nil {
-		return fmt.Errorf("issue encrypting with key: %s", err)
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+
+	if decryptedData == nil {
+		return fmt.Errorf("no response returned from %q", path)
 	}
 
-	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	encoded, ok := decryptedData.Data["plaintext"].(string)
+	if !ok {
+		return fmt.Errorf("plaintext is not set in response")
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("error decoding plaintext: %s", err)
+	}
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
 	d.Set("plaintext", string(plaintext))
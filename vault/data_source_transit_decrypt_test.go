@@ -2,8 +2,10 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -23,6 +25,69 @@ func TestDataSourceTransitDecrypt(t *testing.T) {
 	})
 }
 
+func TestDataSourceTransitDecrypt_errors(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitDecrypt_missingKeyConfig(backend),
+				ExpectError: regexp.MustCompile("issue decrypting with key"),
+			},
+			{
+				Config:      testDataSourceTransitDecrypt_wrongContextConfig(backend),
+				ExpectError: regexp.MustCompile("issue decrypting with key"),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitDecrypt_missingKeyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.test.path
+  key        = "missing"
+  ciphertext = "vault:v1:aW52YWxpZA=="
+}
+`, backend)
+}
+
+func testDataSourceTransitDecrypt_wrongContextConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "derived"
+  backend          = vault_mount.test.path
+  derived          = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+  context   = "right"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.test.path
+  key        = vault_transit_secret_backend_key.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+  context    = "wrong"
+}
+`, backend)
+}
+
 var testDataSourceTransitDecrypt_config = `
 resource "vault_mount" "test" {
   path        = "transit"
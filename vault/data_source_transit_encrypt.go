@@ -3,8 +3,8 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -26,7 +26,7 @@ func transitEncryptDataSource() *schema.Resource {
 			"plaintext": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Map of strings read from Vault.",
+				Description: "Plaintext to be encrypted.",
 				Sensitive:   true,
 			},
 			"context": {
@@ -63,14 +63,22 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"key_version": keyVersion,
 	}
 
-	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
+	path := backend + "/encrypt/" + key
+	encryptedData, err := client.Logical().Write(path, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
 
-	cipherText := encryptedData.Data["ciphertext"]
+	if encryptedData == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	cipherText, ok := encryptedData.Data["ciphertext"].(string)
+	if !ok || cipherText == "" {
+		return fmt.Errorf("ciphertext is not set in response")
+	}
 
-	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText.(string))))
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText)))
 	d.Set("ciphertext", cipherText)
 
 	return nil
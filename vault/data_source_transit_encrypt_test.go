@@ -2,8 +2,10 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -23,6 +25,42 @@ func TestDataSourceTransitEncrypt(t *testing.T) {
 	})
 }
 
+func TestDataSourceTransitEncrypt_missingContext(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitEncrypt_missingContextConfig(backend),
+				ExpectError: regexp.MustCompile("issue encrypting with key"),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitEncrypt_missingContextConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "derived"
+  backend          = vault_mount.test.path
+  derived          = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+}
+`, backend)
+}
+
 var testDataSourceTransitEncrypt_config = `
 resource "vault_mount" "test" {
   path        = "transit"
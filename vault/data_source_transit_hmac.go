@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitHMACDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitHMACDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to use.",
+			},
+			"input": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Base64 encoded input data.",
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The hash algorithm to use.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"sha2-224", "sha2-256", "sha2-384", "sha2-512",
+					"sha3-224", "sha3-256", "sha3-384", "sha3-512",
+				}, false),
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for generating the HMAC.",
+			},
+			"hmac": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HMAC returned from Vault.",
+			},
+		},
+	}
+}
+
+func transitHMACDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"input": d.Get("input").(string),
+	}
+	for _, k := range []string{"algorithm", "key_version"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	path := d.Get("backend").(string) + "/hmac/" + d.Get("key").(string)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("issue generating HMAC with key: %s", err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	hmac, ok := resp.Data["hmac"].(string)
+	if !ok || hmac == "" {
+		return fmt.Errorf("hmac is not set in response")
+	}
+
+	d.SetId(hmac)
+	d.Set("hmac", hmac)
+
+	return nil
+}
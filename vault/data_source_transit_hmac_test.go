@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitHMAC(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitHMAC_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_hmac.test", "hmac", regexp.MustCompile("^vault:v1:")),
+					// the HMAC is deterministic for the same key, input and algorithm
+					resource.TestCheckResourceAttrPair(
+						"data.vault_transit_hmac.test", "hmac",
+						"data.vault_transit_hmac.again", "hmac"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitHMAC_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_hmac" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  input     = base64encode("foo")
+  algorithm = "sha2-512"
+}
+
+data "vault_transit_hmac" "again" {
+  backend     = vault_mount.test.path
+  key         = vault_transit_secret_backend_key.test.name
+  input       = base64encode("foo")
+  algorithm   = "sha2-512"
+  key_version = 1
+}
+`, backend)
+}
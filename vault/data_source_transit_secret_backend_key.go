@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitSecretBackendKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitSecretBackendKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Specifies the type of key.",
+			},
+			"keys": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of key versions to their creation time.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in use in the keyring.",
+			},
+			"min_decryption_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for decryption.",
+			},
+			"min_encryption_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for encryption.",
+			},
+			"supports_encryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports encryption, based on key type.",
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the keys are exportable.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := transitSecretBackendKeyPath(backend, name)
+
+	log.Printf("[DEBUG] Reading transit key %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transit key %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no transit key found at %q", path)
+	}
+
+	keys, err := transitSecretBackendKeyVersions(secret.Data["keys"])
+	if err != nil {
+		return fmt.Errorf("error reading keys for transit key %q: %s", path, err)
+	}
+
+	d.SetId(path)
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+
+	for _, k := range []string{
+		"type",
+		"latest_version",
+		"min_decryption_version",
+		"min_encryption_version",
+		"supports_encryption",
+		"exportable",
+	} {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for transit key %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+// transitSecretBackendKeyVersions maps each key version to its creation time.
+// Symmetric keys report the creation time as a unix timestamp, whereas
+// asymmetric keys report it in RFC3339 along with their public key.
+func transitSecretBackendKeyVersions(v interface{}) (map[string]string, error) {
+	ikeys, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for keys", v)
+	}
+
+	keys := make(map[string]string, len(ikeys))
+	for version, iv := range ikeys {
+		switch kv := iv.(type) {
+		case json.Number:
+			ts, err := kv.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("expected creation time %q of version %s to be a number", kv, version)
+			}
+			keys[version] = time.Unix(ts, 0).UTC().Format(time.RFC3339)
+		case map[string]interface{}:
+			if ct, ok := kv["creation_time"].(string); ok {
+				keys[version] = ct
+			}
+		default:
+			return nil, fmt.Errorf("unexpected type %T for version %s", iv, version)
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitSecretBackendKeyExportDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitSecretBackendKeyExportDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to export.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Type of the key to export; one of 'encryption-key', 'signing-key' or 'hmac-key'.",
+				ValidateFunc: validation.StringInSlice([]string{"encryption-key", "signing-key", "hmac-key"}, false),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version of the key to export, or 'latest'. All versions are exported if unset.",
+			},
+			"keys": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Map of key versions to the exported key material.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyExportDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	keyPath := backend + "/keys/" + name
+
+	// Check up front, Vault only responds with a 400 for keys that are not exportable.
+	log.Printf("[DEBUG] Reading transit key %q", keyPath)
+	key, err := client.Logical().Read(keyPath)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", keyPath, err)
+	}
+	if key == nil {
+		return fmt.Errorf("transit key %q not found", keyPath)
+	}
+	if exportable, _ := key.Data["exportable"].(bool); !exportable {
+		return fmt.Errorf("transit key %q is not exportable, set exportable to true on the key to export it", keyPath)
+	}
+
+	path := backend + "/export/" + d.Get("type").(string) + "/" + name
+	if v, ok := d.GetOk("version"); ok {
+		path += "/" + v.(string)
+	}
+
+	log.Printf("[DEBUG] Exporting transit key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error exporting transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Exported transit key %q", path)
+	if resp == nil {
+		return fmt.Errorf("no transit key exported at %q", path)
+	}
+
+	d.SetId(path)
+	if err := d.Set("keys", resp.Data["keys"]); err != nil {
+		return err
+	}
+
+	return nil
+}
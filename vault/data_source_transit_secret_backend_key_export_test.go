@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSecretBackendKeyExport(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	dataSourceName := "data.vault_transit_secret_backend_key_export.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitSecretBackendKeyExport_config(backend, false, ""),
+				ExpectError: regexp.MustCompile(`transit key ".+/keys/test" is not exportable`),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendKeyExport_config(backend, true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.%", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.1"),
+				),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendKeyExport_config(backend, true, "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version", "latest"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.%", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSecretBackendKeyExport_config(backend string, exportable bool, version string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  exportable       = %t
+  deletion_allowed = true
+}
+
+data "vault_transit_secret_backend_key_export" "test" {
+  backend = vault_mount.test.path
+  name    = vault_transit_secret_backend_key.test.name
+  type    = "encryption-key"
+  version = "%s"
+}
+`, backend, exportable, version)
+}
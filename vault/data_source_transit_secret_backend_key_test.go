@@ -0,0 +1,109 @@
+package vault
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSecretBackendKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	dataSourceName := "data.vault_transit_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSecretBackendKey_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "backend", backend),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "min_decryption_version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "min_encryption_version", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "supports_encryption", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "exportable", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.%", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSecretBackendKey_config(backend string) string {
+	return `
+resource "vault_mount" "test" {
+  path = "` + backend + `"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_secret_backend_key" "test" {
+  backend = vault_mount.test.path
+  name    = vault_transit_secret_backend_key.test.name
+}
+`
+}
+
+func TestTransitSecretBackendKeyVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    interface{}
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "symmetric",
+			keys: map[string]interface{}{
+				"1": json.Number("1650000000"),
+				"2": json.Number("1660000000"),
+			},
+			want: map[string]string{
+				"1": "2022-04-15T05:20:00Z",
+				"2": "2022-08-08T23:06:40Z",
+			},
+		},
+		{
+			name: "asymmetric",
+			keys: map[string]interface{}{
+				"1": map[string]interface{}{
+					"creation_time": "2022-04-15T05:20:00.000000000Z",
+					"name":          "ed25519",
+					"public_key":    "public",
+				},
+			},
+			want: map[string]string{
+				"1": "2022-04-15T05:20:00.000000000Z",
+			},
+		},
+		{
+			name:    "invalid",
+			keys:    "foo",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transitSecretBackendKeyVersions(tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transitSecretBackendKeyVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("transitSecretBackendKeyVersions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// transitSignFields returns the schema shared by the transit sign and verify
+// data sources.
+func transitSignFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Transit secret backend the key belongs to.",
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the signing key to use.",
+		},
+		"input": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Base64 encoded input data.",
+			ValidateFunc: validation.StringIsBase64,
+		},
+		"hash_algorithm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The hash algorithm to use.",
+			ValidateFunc: validation.StringInSlice([]string{
+				"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512",
+				"sha3-224", "sha3-256", "sha3-384", "sha3-512", "none",
+			}, false),
+		},
+		"signature_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The signature algorithm to use when using RSA keys; one of 'pss' or 'pkcs1v15'.",
+			ValidateFunc: validation.StringInSlice([]string{"pss", "pkcs1v15"}, false),
+		},
+		"prehashed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Set to true when the input is already hashed.",
+		},
+		"salt_length": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The salt length used to sign when using the 'pss' signature algorithm; 'auto', 'hash' or an integer.",
+		},
+	}
+}
+
+func transitSignData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"input":     d.Get("input").(string),
+		"prehashed": d.Get("prehashed").(bool),
+	}
+
+	for _, k := range []string{"hash_algorithm", "signature_algorithm", "salt_length"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
+func transitSignDataSource() *schema.Resource {
+	fields := transitSignFields()
+	fields["key_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The version of the key to use for signing.",
+	}
+	fields["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The signature returned from Vault.",
+	}
+
+	return &schema.Resource{
+		Read:   transitSignDataSourceRead,
+		Schema: fields,
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := transitSignData(d)
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v
+	}
+
+	path := d.Get("backend").(string) + "/sign/" + d.Get("key").(string)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("issue signing with key: %s", err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	signature, ok := resp.Data["signature"].(string)
+	if !ok || signature == "" {
+		return fmt.Errorf("signature is not set in response")
+	}
+
+	d.SetId(signature)
+	d.Set("signature", signature)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSign(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSign_config(backend, "ecdsa-p256", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile("^vault:v1:")),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.other", "valid", "false"),
+				),
+			},
+			{
+				Config: testDataSourceTransitSign_config(backend, "rsa-2048", `
+  signature_algorithm = "pss"
+  salt_length         = "hash"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile("^vault:v1:")),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.other", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSign_config(backend, keyType, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test-%s"
+  backend          = vault_mount.test.path
+  type             = "%s"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  input   = base64encode("foo")
+  %s
+}
+
+data "vault_transit_verify" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  input     = base64encode("foo")
+  signature = data.vault_transit_sign.test.signature
+  %s
+}
+
+data "vault_transit_verify" "other" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  input     = base64encode("bar")
+  signature = data.vault_transit_sign.test.signature
+  %s
+}
+`, backend, keyType, keyType, extra, extra, extra)
+}
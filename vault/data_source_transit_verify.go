@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitVerifyDataSource() *schema.Resource {
+	fields := transitSignFields()
+	fields["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The signature to verify.",
+	}
+	fields["valid"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the signature is valid for the input.",
+	}
+
+	return &schema.Resource{
+		Read:   transitVerifyDataSourceRead,
+		Schema: fields,
+	}
+}
+
+func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	signature := d.Get("signature").(string)
+	data := transitSignData(d)
+	data["signature"] = signature
+
+	path := d.Get("backend").(string) + "/verify/" + d.Get("key").(string)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("issue verifying with key: %s", err)
+	}
+
+	if resp == nil {
+		return fmt.Errorf("no response returned from %q", path)
+	}
+
+	valid, ok := resp.Data["valid"].(bool)
+	if !ok {
+		return fmt.Errorf("valid is not set in response")
+	}
+
+	d.SetId(signature)
+	d.Set("valid", valid)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package vault
+
+import (
+	"strings"
+)
+
+const identityMFAMethodBasePath = "identity/mfa/method"
+
+func identityMFAMethodPath(methodType string) string {
+	return identityMFAMethodBasePath + "/" + methodType
+}
+
+func identityMFAMethodIDPath(methodType, id string) string {
+	return identityMFAMethodPath(methodType) + "/" + strings.Trim(id, "/")
+}
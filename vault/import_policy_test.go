@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -24,6 +25,12 @@ func TestAccPolicy_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:  "vault_policy.test",
+				ImportState:   true,
+				ImportStateId: name + "-missing",
+				ExpectError:   regexp.MustCompile(`Cannot import non-existent remote object`),
+			},
 		},
 	})
 }
@@ -145,3 +145,9 @@ func addPrefixToVKVPath(p, mountPath, apiPrefix string) string {
 		return path.Join(mountPath, apiPrefix, p)
 	}
 }
+
+// getKVV2Path returns the full request path for a KV-V2 secret, e.g.
+// "<mount>/<prefix>/<name>", where prefix is one of "data" or "metadata".
+func getKVV2Path(mount, name, prefix string) string {
+	return strings.Trim(mount, "/") + "/" + strings.Trim(prefix, "/") + "/" + strings.Trim(name, "/")
+}
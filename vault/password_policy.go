@@ -66,6 +66,12 @@ func passwordPolicyRead(attributes []string, d *schema.ResourceData, meta interf
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
+	if policy == nil {
+		log.Printf("[WARN] Password policy %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
 	for _, value := range attributes {
 		d.Set(value, policy[value])
 	}
@@ -107,3 +113,14 @@ func passwordPolicyWrite(attributes []string, d *schema.ResourceData, meta inter
 
 	return passwordPolicyRead(attributes, d, meta)
 }
+
+// validatePasswordPolicy generates a single password from the named policy,
+// returning an error if Vault is unable to do so.
+func validatePasswordPolicy(client *api.Client, name string) error {
+	log.Printf("[DEBUG] Validating %s password policy", name)
+	if _, err := client.Logical().Read(fmt.Sprintf("sys/policies/password/%s/generate", name)); err != nil {
+		return fmt.Errorf("password policy %q failed to generate a password: %s", name, err)
+	}
+
+	return nil
+}
@@ -7,12 +7,14 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-secure-stdlib/awsutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
 
@@ -178,7 +180,7 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", DefaultMaxHTTPRetries),
-				Description: "Maximum number of retries when a 5xx error code is encountered.",
+				Description: "Maximum number of retries when a 5xx or 429 error code is encountered.",
 			},
 			"max_retries_ccc": {
 				Type:        schema.TypeInt,
@@ -186,6 +188,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES_CCC", DefaultMaxHTTPRetriesCCC),
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Timeout in seconds for each request to Vault, including retries.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -250,6 +258,10 @@ var (
 			Resource:      identityEntityDataSource(),
 			PathInventory: []string{"/identity/lookup/entity"},
 		},
+		"vault_identity_entity_alias": {
+			Resource:      identityEntityAliasDataSource(),
+			PathInventory: []string{"/identity/entity-alias/id"},
+		},
 		"vault_identity_group": {
 			Resource:      identityGroupDataSource(),
 			PathInventory: []string{"/identity/lookup/group"},
@@ -266,6 +278,10 @@ var (
 			Resource:      adAccessCredentialsDataSource(),
 			PathInventory: []string{"/ad/creds/{role}"},
 		},
+		"vault_consul_secret_backend_credentials": {
+			Resource:      consulSecretBackendCredentialsDataSource(),
+			PathInventory: []string{"/consul/creds/{role}"},
+		},
 		"vault_nomad_access_token": {
 			Resource:      nomadAccessCredentialsDataSource(),
 			PathInventory: []string{"/nomad/creds/{role}"},
@@ -282,10 +298,48 @@ var (
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_pki_secret_backend_issuers": {
+			Resource:      pkiSecretBackendIssuersDataSource(),
+			PathInventory: []string{"/pki/issuers"},
+		},
+		"vault_kv_secret_v2": {
+			Resource:      kvSecretV2DataSource(),
+			PathInventory: []string{"/secret/data/{path}"},
+		},
+		"vault_kv_secret_subkeys_v2": {
+			Resource:      kvSecretSubkeysV2DataSource(),
+			PathInventory: []string{"/secret/subkeys/{path}"},
+		},
+		"vault_kv_secrets_list_v2": {
+			Resource:      kvSecretListDataSourceV2(),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
+		"vault_namespace": {
+			Resource:       namespaceDataSource(),
+			PathInventory:  []string{"/sys/namespaces/{path}"},
+			EnterpriseOnly: true,
+		},
+		"vault_namespaces": {
+			Resource:       namespacesDataSource(),
+			PathInventory:  []string{"/sys/namespaces"},
+			EnterpriseOnly: true,
+		},
+		"vault_ldap_static_credentials": {
+			Resource:      ldapStaticCredentialsDataSource(),
+			PathInventory: []string{"/ldap/static-cred/{role}"},
+		},
+		"vault_totp_code": {
+			Resource:      totpCodeDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
+		"vault_random_bytes": {
+			Resource:      randomBytesDataSource(),
+			PathInventory: []string{"/sys/tools/random/{bytes}", "/transit/random/{bytes}"},
+		},
 		"vault_auth_backend": {
 			Resource:      authBackendDataSource(),
 			PathInventory: []string{"/sys/auth"},
@@ -298,10 +352,39 @@ var (
 			Resource:      transitDecryptDataSource(),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_cmac": {
+			Resource:       transitCMACDataSource(),
+			PathInventory:  []string{"/transit/cmac/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_transit_hmac": {
+			Resource:      transitHMACDataSource(),
+			PathInventory: []string{"/transit/hmac/{name}"},
+		},
+		"vault_transit_sign": {
+			Resource:      transitSignDataSource(),
+			PathInventory: []string{"/transit/sign/{name}"},
+		},
+		"vault_transit_verify": {
+			Resource:      transitVerifyDataSource(),
+			PathInventory: []string{"/transit/verify/{name}"},
+		},
+		"vault_transit_secret_backend_key": {
+			Resource:      transitSecretBackendKeyDataSource(),
+			PathInventory: []string{"/transit/keys/{name}"},
+		},
+		"vault_transit_secret_backend_key_export": {
+			Resource:      transitSecretBackendKeyExportDataSource(),
+			PathInventory: []string{"/transit/export/{type}/{name}/{version}"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      gcpAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_gcp_secret_impersonated_account_token": {
+			Resource:      gcpSecretImpersonatedAccountTokenDataSource(),
+			PathInventory: []string{"/gcp/impersonated-account/{name}/token"},
+		},
 		"vault_identity_oidc_client_creds": {
 			Resource:      identityOIDCClientCredsDataSource(),
 			PathInventory: []string{"/identity/oidc/client/{name}"},
@@ -372,6 +455,10 @@ var (
 			Resource:      awsAuthBackendClientResource(),
 			PathInventory: []string{"/auth/aws/config/client"},
 		},
+		"vault_aws_auth_backend_config_identity": {
+			Resource:      awsAuthBackendConfigIdentityResource(),
+			PathInventory: []string{"/auth/aws/config/identity"},
+		},
 		"vault_aws_auth_backend_identity_whitelist": {
 			Resource:      awsAuthBackendIdentityWhitelistResource(),
 			PathInventory: []string{"/auth/aws/config/tidy/identity-whitelist"},
@@ -464,6 +551,10 @@ var (
 			Resource:      gcpAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/gcp/role/{name}"},
 		},
+		"vault_gcp_auth_backend_role_service_account": {
+			Resource:      gcpAuthBackendRoleServiceAccountResource(),
+			PathInventory: []string{"/auth/gcp/role/{name}/service-accounts"},
+		},
 		"vault_gcp_secret_backend": {
 			Resource:      gcpSecretBackendResource("vault_gcp_secret_backend"),
 			PathInventory: []string{"/gcp/config"},
@@ -472,6 +563,10 @@ var (
 			Resource:      gcpSecretRolesetResource(),
 			PathInventory: []string{"/gcp/roleset/{name}"},
 		},
+		"vault_gcp_secret_impersonated_account": {
+			Resource:      gcpSecretImpersonatedAccountResource(),
+			PathInventory: []string{"/gcp/impersonated-account/{name}"},
+		},
 		"vault_gcp_secret_static_account": {
 			Resource:      gcpSecretStaticAccountResource(),
 			PathInventory: []string{"/gcp/static-account/{name}"},
@@ -504,6 +599,10 @@ var (
 			Resource:      kubernetesAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_secret_backend_role": {
+			Resource:      kubernetesSecretBackendRoleResource(),
+			PathInventory: []string{"/kubernetes/roles/{name}"},
+		},
 		"vault_okta_auth_backend": {
 			Resource:      oktaAuthBackendResource(),
 			PathInventory: []string{"/auth/okta/config"},
@@ -516,6 +615,36 @@ var (
 			Resource:      oktaAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/okta/groups/{name}"},
 		},
+		"vault_userpass_auth_backend_user": {
+			Resource: userpassAuthBackendUserResource(),
+			PathInventory: []string{
+				"/auth/userpass/users/{username}",
+				"/auth/userpass/users/{username}/password",
+			},
+		},
+		"vault_kv_secret_backend_v2": {
+			Resource:      kvSecretBackendV2Resource(),
+			PathInventory: []string{"/secret/config"},
+		},
+		"vault_kv_secret_v2": {
+			Resource: kvSecretV2Resource("vault_kv_secret_v2"),
+			PathInventory: []string{
+				"/secret/data/{path}",
+				"/secret/metadata/{path}",
+			},
+		},
+		"vault_ldap_secret_backend": {
+			Resource:      ldapSecretBackendResource(),
+			PathInventory: []string{"/ldap/config"},
+		},
+		"vault_ldap_secret_backend_static_role": {
+			Resource:      ldapSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/ldap/static-role/{role_name}"},
+		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      ldapSecretBackendDynamicRoleResource(),
+			PathInventory: []string{"/ldap/role/{role_name}"},
+		},
 		"vault_ldap_auth_backend": {
 			Resource:      ldapAuthBackendResource(),
 			PathInventory: []string{"/auth/ldap/config"},
@@ -528,6 +657,10 @@ var (
 			Resource:      ldapAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/ldap/groups/{name}"},
 		},
+		"vault_ldap_auth_backend_group_policies": {
+			Resource:      ldapAuthBackendGroupPoliciesResource(),
+			PathInventory: []string{"/auth/ldap/groups/{name}"},
+		},
 		"vault_nomad_secret_backend": {
 			Resource: nomadSecretAccessBackendResource(),
 			PathInventory: []string{
@@ -540,6 +673,18 @@ var (
 			Resource:      nomadSecretBackendRoleResource(),
 			PathInventory: []string{"/nomad/role/{role}"},
 		},
+		"vault_plugin": {
+			Resource:      pluginResource(),
+			PathInventory: []string{"/sys/plugins/catalog/{type}/{name}"},
+		},
+		"vault_plugin_pinned_version": {
+			Resource:      pluginPinnedVersionResource(),
+			PathInventory: []string{"/sys/plugins/pins/{type}/{name}"},
+		},
+		"vault_plugin_reload": {
+			Resource:      pluginReloadResource(),
+			PathInventory: []string{"/sys/plugins/reload/backend"},
+		},
 		"vault_policy": {
 			Resource:      policyResource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -554,6 +699,18 @@ var (
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_managed_keys": {
+			Resource: managedKeysResource(),
+			PathInventory: []string{
+				"/sys/managed-keys/{type}/{name}",
+			},
+			EnterpriseOnly: true,
+		},
+		"vault_control_group_config": {
+			Resource:       controlGroupConfigResource(),
+			PathInventory:  []string{"/sys/config/control-group"},
+			EnterpriseOnly: true,
+		},
 		"vault_mfa_duo": {
 			Resource:       mfaDuoResource(),
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
@@ -623,6 +780,18 @@ var (
 			Resource:      identityGroupPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_mfa_duo": {
+			Resource:      identityMFADuoResource(),
+			PathInventory: []string{"/identity/mfa/method/duo"},
+		},
+		"vault_identity_mfa_totp": {
+			Resource:      identityMFATOTPResource(),
+			PathInventory: []string{"/identity/mfa/method/totp"},
+		},
+		"vault_identity_mfa_login_enforcement": {
+			Resource:      identityMFALoginEnforcementResource(),
+			PathInventory: []string{"/identity/mfa/login-enforcement/{name}"},
+		},
 		"vault_identity_oidc": {
 			Resource:      identityOidc(),
 			PathInventory: []string{"/identity/oidc/config"},
@@ -714,6 +883,10 @@ var (
 			Resource:      terraformCloudSecretRoleResource(),
 			PathInventory: []string{"/terraform/role/{name}"},
 		},
+		"vault_totp_secret_backend_key": {
+			Resource:      totpSecretBackendKeyResource(),
+			PathInventory: []string{"/totp/keys/{name}"},
+		},
 		"vault_transit_secret_backend_key": {
 			Resource:      transitSecretBackendKeyResource(),
 			PathInventory: []string{"/transit/keys/{name}"},
@@ -867,6 +1040,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	client.SetMaxRetries(d.Get("max_retries").(int))
 
+	// a zero value keeps the api.Client default, which honours VAULT_CLIENT_TIMEOUT
+	if v := d.Get("request_timeout").(int); v > 0 {
+		client.SetClientTimeout(time.Duration(v) * time.Second)
+	}
+
 	maxHTTPRetriesCCC = d.Get("max_retries_ccc").(int)
 
 	// Try an get the token from the config or token helper
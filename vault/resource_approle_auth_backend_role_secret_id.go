@@ -49,6 +49,16 @@ func approleAuthBackendRoleSecretIDResource(name string) *schema.Resource {
 				ForceNew: true,
 			},
 
+			"token_bound_cidrs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of CIDR blocks that can use tokens generated with the SecretID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ForceNew: true,
+			},
+
 			"metadata": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -142,6 +152,9 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	if len(cidrs) > 0 {
 		data["cidr_list"] = strings.Join(cidrs, ",")
 	}
+	if v, ok := d.GetOk("token_bound_cidrs"); ok {
+		data["token_bound_cidrs"] = v.(*schema.Set).List()
+	}
 	if v, ok := d.GetOk("metadata"); ok {
 		name := "vault_approle_auth_backend_role_secret_id"
 		result, err := normalizeDataJSON(v.(string))
@@ -178,6 +191,11 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	var accessor string
 
 	if wrapped {
+		if resp == nil || resp.WrapInfo == nil {
+			return fmt.Errorf("no wrapping info returned for AppRole auth backend role SecretID %q", path)
+		}
+
+		// the raw SecretID is never returned in a wrapped response
 		if withWrappedAccessor {
 			accessor = resp.WrapInfo.WrappedAccessor
 		} else {
@@ -241,21 +259,14 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	var cidrs []string
-	switch data := resp.Data["cidr_list"].(type) {
-	case string:
-		if data != "" {
-			cidrs = strings.Split(data, ",")
-		}
-	case []interface{}:
-		cidrs = make([]string, 0, len(data))
-		for _, i := range data {
-			cidrs = append(cidrs, i.(string))
-		}
-	case nil:
-		cidrs = make([]string, 0)
-	default:
-		return fmt.Errorf("unknown type %T for cidr_list in response for SecretID %q", data, accessor)
+	cidrs, err := approleAuthBackendRoleSecretIDFlattenCIDRs(resp.Data["cidr_list"])
+	if err != nil {
+		return fmt.Errorf("error reading cidr_list for SecretID %q: %s", accessor, err)
+	}
+
+	tokenBoundCIDRs, err := approleAuthBackendRoleSecretIDFlattenCIDRs(resp.Data["token_bound_cidrs"])
+	if err != nil {
+		return fmt.Errorf("error reading token_bound_cidrs for SecretID %q: %s", accessor, err)
 	}
 
 	metadata, err := json.Marshal(resp.Data["metadata"])
@@ -269,6 +280,10 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return fmt.Errorf("error setting cidr_list in state: %s", err)
 	}
+	err = d.Set("token_bound_cidrs", tokenBoundCIDRs)
+	if err != nil {
+		return fmt.Errorf("error setting token_bound_cidrs in state: %s", err)
+	}
 	d.Set("metadata", string(metadata))
 	d.Set("accessor", accessor)
 
@@ -369,3 +384,25 @@ func approleAuthBackendRoleSecretIDParseID(id string) (backend, role, accessor s
 
 	return
 }
+
+// approleAuthBackendRoleSecretIDFlattenCIDRs converts a CIDR list returned by
+// Vault, either a comma-separated string or a list, to a slice of strings.
+func approleAuthBackendRoleSecretIDFlattenCIDRs(v interface{}) ([]string, error) {
+	switch data := v.(type) {
+	case string:
+		if data == "" {
+			return []string{}, nil
+		}
+		return strings.Split(data, ","), nil
+	case []interface{}:
+		cidrs := make([]string, 0, len(data))
+		for _, i := range data {
+			cidrs = append(cidrs, i.(string))
+		}
+		return cidrs, nil
+	case nil:
+		return []string{}, nil
+	}
+
+	return nil, fmt.Errorf("unknown type %T", v)
+}
@@ -54,6 +54,8 @@ func TestAccAppRoleAuthBackendRoleSecretID_wrapped(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_accessor"),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_token"),
+					resource.TestCheckResourceAttr(secretIDResource, "secret_id", ""),
+					resource.TestCheckResourceAttr(secretIDResource, "wrapping_ttl", "60s"),
 				),
 			},
 		},
@@ -113,6 +115,8 @@ func TestAccAppRoleAuthBackendRoleSecretID_wrapped_namespace(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_accessor"),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_token"),
+					resource.TestCheckResourceAttr(secretIDResource, "secret_id", ""),
+					resource.TestCheckResourceAttr(secretIDResource, "wrapping_ttl", "60s"),
 				),
 			},
 		},
@@ -173,6 +177,8 @@ func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "secret_id", secretID),
 					resource.TestCheckResourceAttrSet(secretIDResource, "accessor"),
 					resource.TestCheckResourceAttr(secretIDResource, "cidr_list.#", "2"),
+					resource.TestCheckResourceAttr(secretIDResource, "token_bound_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(secretIDResource, "token_bound_cidrs.*", "10.148.0.0/24"),
 					resource.TestCheckResourceAttr(secretIDResource, "metadata", `{"hello":"world"}`),
 				),
 			},
@@ -234,6 +240,7 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
   role_name = vault_approle_auth_backend_role.role.role_name
   backend = vault_auth_backend.approle.path
   cidr_list = ["10.148.0.0/20", "10.150.0.0/20"]
+  token_bound_cidrs = ["10.148.0.0/24"]
   metadata = <<EOF
 {
   "hello": "world"
@@ -1,11 +1,14 @@
 package vault
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -17,6 +20,7 @@ func auditResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: auditCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -27,10 +31,11 @@ func auditResource() *schema.Resource {
 				Description: "Path in which to enable the audit device.",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Type of the audit device, such as 'file'.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the audit device. Can be one of 'file', 'syslog' or 'socket'.",
+				ValidateFunc: validation.StringInSlice([]string{"file", "syslog", "socket"}, false),
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -55,6 +60,22 @@ func auditResource() *schema.Resource {
 	}
 }
 
+func auditCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("type").(string) != "file" || !d.NewValueKnown("options") {
+		return nil
+	}
+
+	// Vault still accepts the legacy "path" option in place of "file_path".
+	options := d.Get("options").(map[string]interface{})
+	for _, k := range []string{"file_path", "path"} {
+		if _, ok := options[k]; ok {
+			return nil
+		}
+	}
+
+	return errors.New("the file_path option is required for file audit devices")
+}
+
 func auditWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -26,6 +27,40 @@ func TestResourceAudit(t *testing.T) {
 	})
 }
 
+func TestResourceAudit_fileOptions(t *testing.T) {
+	path := "example-" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_audit" "test" {
+	path = "%s"
+	type = "file"
+	options = {
+		format = "json"
+	}
+}
+`, path),
+				ExpectError: regexp.MustCompile("the file_path option is required for file audit devices"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_audit" "test" {
+	path = "%s"
+	type = "file"
+	options = {
+		file_path = "stdout"
+	}
+}
+`, path),
+				Check: resource.TestCheckResourceAttr("vault_audit.test", "options.file_path", "stdout"),
+			},
+		},
+	})
+}
+
 func testResourceAudit_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_audit" "test" {
@@ -1,8 +1,10 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -17,7 +19,7 @@ func AuthBackendResource() *schema.Resource {
 		Read:   authBackendRead,
 		Update: authBackendUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: authBackendImport,
 		},
 		MigrateState: resourceAuthBackendMigrateState,
 
@@ -139,6 +141,37 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// authBackendImport looks up the auth mount by path, erroring if it does not
+// exist, and populates its tune settings, which are otherwise only ever
+// written by this resource.
+func authBackendImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Id(), "/")
+
+	log.Printf("[DEBUG] Importing auth %q from Vault", path)
+	mount, err := getAuthMountIfPresent(client, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if mount == nil {
+		return nil, fmt.Errorf("auth mount %q not found", path)
+	}
+
+	rawTune, err := authMountTuneGet(client, "auth/"+path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading tune information from Vault: %s", err)
+	}
+
+	d.SetId(path)
+	if err := d.Set("tune", []map[string]interface{}{rawTune}); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
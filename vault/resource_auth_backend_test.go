@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -32,6 +33,18 @@ func TestResourceAuth(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "path", path),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tune"},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: path + "-missing",
+				ExpectError:   regexp.MustCompile(`auth mount ".+" not found`),
+			},
 		},
 	})
 }
@@ -228,6 +241,27 @@ func TestResourceAuthTune(t *testing.T) {
 					checkAuthMount(backend, maxLeaseTtl(7200)),
 				),
 			},
+			{
+				ResourceName: resName,
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					attrs := states[0].Attributes
+					for k, want := range map[string]string{
+						"type":                      "github",
+						"tune.0.default_lease_ttl":  "1m",
+						"tune.0.max_lease_ttl":      "2h",
+						"tune.0.listing_visibility": "unauth",
+					} {
+						if got := attrs[k]; got != want {
+							return fmt.Errorf("expected %s to be %q, got %q", k, want, got)
+						}
+					}
+					return nil
+				},
+			},
 		},
 	})
 }
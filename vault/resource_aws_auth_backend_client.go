@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -65,6 +66,18 @@ func awsAuthBackendClientResource() *schema.Resource {
 				Optional:    true,
 				Description: "Region to override the default region for making AWS STS API calls.",
 			},
+			"use_sts_region_from_client": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, overrides sts_region and instead uses the region from the client request headers for STS API calls.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				Description:  "Number of max retries the client should use for recoverable errors. The default of -1 falls back to the AWS SDK's default behavior.",
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
 			"iam_server_id_header_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -94,6 +107,8 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"iam_endpoint":               iamEndpoint,
 		"sts_endpoint":               stsEndpoint,
 		"sts_region":                 stsRegion,
+		"use_sts_region_from_client": d.Get("use_sts_region_from_client").(bool),
+		"max_retries":                d.Get("max_retries").(int),
 		"iam_server_id_header_value": iamServerIDHeaderValue,
 	}
 
@@ -149,6 +164,16 @@ func awsAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("sts_endpoint", secret.Data["sts_endpoint"])
 	d.Set("sts_region", secret.Data["sts_region"])
 	d.Set("iam_server_id_header_value", secret.Data["iam_server_id_header_value"])
+
+	// Older versions of Vault don't return these.
+	for _, k := range []string{"use_sts_region_from_client", "max_retries"} {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -47,7 +47,10 @@ func TestAccAWSAuthBackendClient_basic(t *testing.T) {
 			},
 			{
 				Config: testAccAWSAuthBackendClientConfig_updated(backend),
-				Check:  testAccAWSAuthBackendClientCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "5"),
+				),
 			},
 		},
 	})
@@ -217,6 +220,7 @@ resource "vault_aws_auth_backend_client" "client" {
   sts_endpoint = "http://updated.vault.test/sts"
   sts_region = "updated-vault-test"
   iam_server_id_header_value = "updated.vault.test"
+  max_retries = 5
 }`, backend)
 }
 
@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var awsAuthBackendConfigIdentityBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/identity$")
+
+func awsAuthBackendConfigIdentityResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendConfigIdentityWrite,
+		Read:   awsAuthBackendConfigIdentityRead,
+		Update: awsAuthBackendConfigIdentityWrite,
+		Delete: awsAuthBackendConfigIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"iam_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "role_id",
+				Description:  "How to generate the identity alias when using the iam auth method; one of 'role_id', 'unique_id' or 'full_arn'.",
+				ValidateFunc: validation.StringInSlice([]string{"role_id", "unique_id", "full_arn"}, false),
+			},
+			"iam_metadata": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The metadata to include on the token returned by the login endpoint when using the iam auth method.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ec2_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "role_id",
+				Description:  "How to generate the identity alias when using the ec2 auth method; one of 'role_id', 'instance_id' or 'image_id'.",
+				ValidateFunc: validation.StringInSlice([]string{"role_id", "instance_id", "image_id"}, false),
+			},
+			"ec2_metadata": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The metadata to include on the token returned by the login endpoint when using the ec2 auth method.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func awsAuthBackendConfigIdentityWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := awsAuthBackendConfigIdentityPath(backend)
+
+	data := map[string]interface{}{
+		"iam_alias":    d.Get("iam_alias").(string),
+		"iam_metadata": d.Get("iam_metadata").(*schema.Set).List(),
+		"ec2_alias":    d.Get("ec2_alias").(string),
+		"ec2_metadata": d.Get("ec2_metadata").(*schema.Set).List(),
+	}
+
+	log.Printf("[DEBUG] Configuring AWS auth backend identity %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error configuring AWS auth backend identity %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Configured AWS auth backend identity %q", path)
+
+	d.SetId(path)
+
+	return awsAuthBackendConfigIdentityRead(d, meta)
+}
+
+func awsAuthBackendConfigIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	res := awsAuthBackendConfigIdentityBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return fmt.Errorf("invalid path %q for AWS auth backend identity config", path)
+	}
+
+	log.Printf("[DEBUG] Reading AWS auth backend identity config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend identity config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AWS auth backend identity config %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend identity config %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", res[1]); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"iam_alias", "iam_metadata", "ec2_alias", "ec2_metadata"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on AWS auth backend identity config %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func awsAuthBackendConfigIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	// The identity config has no delete endpoint, restore Vault's defaults instead.
+	data := map[string]interface{}{
+		"iam_alias":    "role_id",
+		"iam_metadata": []string{},
+		"ec2_alias":    "role_id",
+		"ec2_metadata": []string{},
+	}
+
+	log.Printf("[DEBUG] Resetting AWS auth backend identity config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error resetting AWS auth backend identity config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset AWS auth backend identity config %q", path)
+
+	return nil
+}
+
+func awsAuthBackendConfigIdentityPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/identity"
+}
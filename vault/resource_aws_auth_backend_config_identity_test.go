@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAWSAuthBackendConfigIdentity(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_config_identity.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendConfigIdentityConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "iam_alias", "role_id"),
+					resource.TestCheckResourceAttr(resourceName, "iam_metadata.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_alias", "role_id"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_metadata.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendConfigIdentityConfig_updated(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "iam_alias", "full_arn"),
+					resource.TestCheckResourceAttr(resourceName, "iam_metadata.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "iam_metadata.*", "account_id"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "iam_metadata.*", "auth_type"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_alias", "instance_id"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_metadata.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ec2_metadata.*", "region"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSAuthBackendConfigIdentityConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+}
+
+resource "vault_aws_auth_backend_config_identity" "test" {
+  backend = vault_auth_backend.aws.path
+}`, backend)
+}
+
+func testAccAWSAuthBackendConfigIdentityConfig_updated(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+}
+
+resource "vault_aws_auth_backend_config_identity" "test" {
+  backend      = vault_auth_backend.aws.path
+  iam_alias    = "full_arn"
+  iam_metadata = ["account_id", "auth_type"]
+  ec2_alias    = "instance_id"
+  ec2_metadata = ["region"]
+}`, backend)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -114,9 +115,10 @@ func awsAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"inferred_entity_type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The type of inferencing Vault should do.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The type of inferencing Vault should do.",
+			ValidateFunc: validation.StringInSlice([]string{"ec2_instance"}, false),
 		},
 		"inferred_aws_region": {
 			Type:        schema.TypeString,
@@ -460,12 +462,9 @@ func awsAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("role_tag"); ok {
 			data["role_tag"] = v.(string)
 		}
-		if v, ok := d.GetOk("allow_instance_migration"); ok {
-			data["allow_instance_migration"] = v.(bool)
-		}
-		if v, ok := d.GetOk("disallow_reauthentication"); ok {
-			data["disallow_reauthentication"] = v.(bool)
-		}
+		// Always sent on update so that the flags can be turned back off.
+		data["allow_instance_migration"] = d.Get("allow_instance_migration").(bool)
+		data["disallow_reauthentication"] = d.Get("disallow_reauthentication").(bool)
 	}
 
 	if authType == "iam" {
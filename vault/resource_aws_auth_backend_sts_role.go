@@ -37,6 +37,11 @@ func awsAuthBackendSTSRoleResource() *schema.Resource {
 				Required:    true,
 				Description: "AWS ARN for STS role to be assumed when interacting with the account specified.",
 			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID expected by the STS role.",
+			},
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -61,10 +66,15 @@ func awsAuthBackendSTSRoleCreate(d *schema.ResourceData, meta interface{}) error
 
 	path := awsAuthBackendSTSRolePath(backend, accountID)
 
-	log.Printf("[DEBUG] Writing STS role %q to AWS auth backend", path)
-	_, err := client.Logical().Write(path, map[string]interface{}{
+	data := map[string]interface{}{
 		"sts_role": stsRole,
-	})
+	}
+	if v, ok := d.GetOk("external_id"); ok {
+		data["external_id"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing STS role %q to AWS auth backend", path)
+	_, err := client.Logical().Write(path, data)
 
 	d.SetId(path)
 
@@ -107,6 +117,10 @@ func awsAuthBackendSTSRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("backend", backend)
 	d.Set("account_id", accountID)
 	d.Set("sts_role", resp.Data["sts_role"])
+	// external_id is not returned by older versions of Vault.
+	if v, ok := resp.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
 	return nil
 }
 
@@ -116,10 +130,13 @@ func awsAuthBackendSTSRoleUpdate(d *schema.ResourceData, meta interface{}) error
 	stsRole := d.Get("sts_role").(string)
 	path := d.Id()
 
+	data := map[string]interface{}{
+		"sts_role":    stsRole,
+		"external_id": d.Get("external_id").(string),
+	}
+
 	log.Printf("[DEBUG] Updating STS role %q in AWS auth backend", path)
-	_, err := client.Logical().Write(path, map[string]interface{}{
-		"sts_role": stsRole,
-	})
+	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating STS role %q in AWS auth backend", path)
 	}
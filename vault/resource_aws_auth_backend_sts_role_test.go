@@ -53,6 +53,13 @@ func TestAccAWSAuthBackendSTSRole_basic(t *testing.T) {
 				Config: testAccAWSAuthBackendSTSRoleConfig_basic(backend, accountID, updatedArn),
 				Check:  testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, updatedArn),
 			},
+			{
+				Config: testAccAWSAuthBackendSTSRoleConfig_externalID(backend, accountID, updatedArn, "external-id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, updatedArn),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_sts_role.role", "external_id", "external-id"),
+				),
+			},
 		},
 	})
 }
@@ -104,7 +111,8 @@ func testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, stsRole string)
 		}
 
 		attrs := map[string]string{
-			"sts_role": "sts_role",
+			"sts_role":    "sts_role",
+			"external_id": "external_id",
 		}
 		for stateAttr, apiAttr := range attrs {
 			if resp.Data[apiAttr] == nil && instanceState.Attributes[stateAttr] == "" {
@@ -132,3 +140,19 @@ resource "vault_aws_auth_backend_sts_role" "role" {
 }
 `, backend, accountID, stsRole)
 }
+
+func testAccAWSAuthBackendSTSRoleConfig_externalID(backend, accountID, stsRole, externalID string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_sts_role" "role" {
+  backend = vault_auth_backend.aws.path
+  account_id = "%s"
+  sts_role = "%s"
+  external_id = "%s"
+}
+`, backend, accountID, stsRole, externalID)
+}
@@ -95,6 +95,19 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 				Optional:    true,
 				Description: "The path for the user name. Valid only when credential_type is iam_user. Default is /",
 			},
+			"session_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Session tags to be set for assume role credentials. Valid only when credential_type is assumed_role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID to set for assume role credentials. Valid only when credential_type is assumed_role.",
+			},
 		},
 	}
 }
@@ -157,6 +170,20 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("user_path is only valid when credential_type is iam_user")
 		}
 	}
+	if d.HasChange("session_tags") {
+		if credentialType == "assumed_role" {
+			data["session_tags"] = d.Get("session_tags")
+		} else {
+			return fmt.Errorf("session_tags is only valid when credential_type is assumed_role")
+		}
+	}
+	if d.HasChange("external_id") {
+		if credentialType == "assumed_role" {
+			data["external_id"] = d.Get("external_id").(string)
+		} else {
+			return fmt.Errorf("external_id is only valid when credential_type is assumed_role")
+		}
+	}
 
 	defaultStsTTL, defaultStsTTLOk := d.GetOk("default_sts_ttl")
 	maxStsTTL, maxStsTTLOk := d.GetOk("max_sts_ttl")
@@ -237,6 +264,14 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["user_path"]; ok {
 		d.Set("user_path", v)
 	}
+	if v, ok := secret.Data["session_tags"]; ok {
+		if err := d.Set("session_tags", v); err != nil {
+			return fmt.Errorf("error setting session_tags for role %q: %s", path, err)
+		}
+	}
+	if v, ok := secret.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
 
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
@@ -187,6 +187,9 @@ func testAccAWSSecretBackendRoleCheckUpdatedAttributes(name, backend string) res
 		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "role_arns.#", "1"),
 		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "role_arns.0", testAccAWSSecretBackendRoleRoleArn_updated),
 		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "iam_groups.#", "2"),
+		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "external_id", "external-id"),
+		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "session_tags.%", "1"),
+		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_role_arns", "session_tags.team", "engineering"),
 		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_policy_inline_to_arn", "name", fmt.Sprintf("%s-policy-inline-to-arn", name)),
 		resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test_policy_inline_to_arn", "backend", backend),
 		testutil.TestCheckResourceAttrJSON("vault_aws_secret_backend_role.test_policy_inline_to_arn", "policy_document", ""),
@@ -325,6 +328,10 @@ resource "vault_aws_secret_backend_role" "test_role_arns" {
     credential_type = "assumed_role"
     iam_groups = ["group1", "group2"]
     backend = vault_aws_secret_backend.test.path
+    external_id = "external-id"
+    session_tags = {
+      team = "engineering"
+    }
 }
 `, name, testAccAWSSecretBackendRoleRoleArn_updated),
 
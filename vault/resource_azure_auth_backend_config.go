@@ -1,11 +1,13 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -60,6 +62,18 @@ func azureAuthBackendConfigResource() *schema.Resource {
 				Optional:    true,
 				Description: "The Azure cloud environment. Valid values: AzurePublicCloud, AzureUSGovernmentCloud, AzureChinaCloud, AzureGermanCloud.",
 			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens. Requires Vault 1.15+.",
+			},
+			"identity_token_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL in seconds of generated identity tokens. Requires Vault 1.15+.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
@@ -85,6 +99,12 @@ func azureAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"resource":      resource,
 		"environment":   environment,
 	}
+	// Sent on change rather than when set, so that removing them clears them.
+	for _, k := range []string{"identity_token_audience", "identity_token_ttl"} {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
 
 	log.Printf("[DEBUG] Writing Azure auth backend config to %q", path)
 	_, err := config.Logical().Write(path, data)
@@ -125,6 +145,18 @@ func azureAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("resource", secret.Data["resource"])
 	d.Set("environment", secret.Data["environment"])
+
+	// The identity token fields are only returned by Vault 1.15+.
+	if v, ok := secret.Data["identity_token_audience"]; ok {
+		d.Set("identity_token_audience", v)
+	}
+	if v, ok := secret.Data["identity_token_ttl"].(json.Number); ok {
+		ttl, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected identity_token_ttl %q to be a number, and it isn't", v)
+		}
+		d.Set("identity_token_ttl", ttl)
+	}
 	return nil
 }
 
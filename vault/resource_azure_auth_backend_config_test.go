@@ -52,6 +52,33 @@ func TestAccAzureAuthBackendConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureAuthBackendConfig_identityToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("azure")
+	resourceName := "vault_azure_auth_backend_config.config"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccCheckAzureAuthBackendConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureAuthBackendConfig_identityToken(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAzureAuthBackendConfigCheck_attrs(backend),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault.example/v1/identity/oidc"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "600"),
+				),
+			},
+			{
+				Config: testAccAzureAuthBackendConfig_updated(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAzureAuthBackendConfigCheck_attrs(backend),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAzureAuthBackendConfigDestroy(s *terraform.State) error {
 	config := testProvider.Meta().(*api.Client)
 
@@ -149,3 +176,21 @@ resource "vault_azure_auth_backend_config" "config" {
   resource = "http://vault.hashicorp.com"
 }`, backend)
 }
+
+func testAccAzureAuthBackendConfig_identityToken(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "azure" {
+  path = "%s"
+  type = "azure"
+  description = "Test auth backend for Azure backend config"
+}
+
+resource "vault_azure_auth_backend_config" "config" {
+  backend = vault_auth_backend.azure.path
+  tenant_id = "11111111-2222-3333-4444-555555555555"
+  client_id = "11111111-2222-3333-4444-555555555555"
+  resource = "http://vault.hashicorp.com"
+  identity_token_audience = "vault.example/v1/identity/oidc"
+  identity_token_ttl = 600
+}`, backend)
+}
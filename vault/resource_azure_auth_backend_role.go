@@ -15,6 +15,15 @@ var (
 	azureAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
 )
 
+var azureAuthBackendRoleBoundFields = []string{
+	"bound_service_principal_ids",
+	"bound_group_ids",
+	"bound_locations",
+	"bound_subscription_ids",
+	"bound_resource_groups",
+	"bound_scale_sets",
+}
+
 func azureAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"role": {
@@ -207,28 +216,10 @@ func azureAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("backend", backend)
 	d.Set("role", role)
 
-	if _, ok := d.GetOk("bound_service_principal_ids"); ok {
-		d.Set("bound_service_principal_ids", resp.Data["bound_service_principal_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_group_ids"); ok {
-		d.Set("bound_group_ids", resp.Data["bound_group_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_locations"); ok {
-		d.Set("bound_locations", resp.Data["bound_locations"])
-	}
-
-	if _, ok := d.GetOk("bound_subscription_ids"); ok {
-		d.Set("bound_subscription_ids", resp.Data["bound_subscription_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_resource_groups"); ok {
-		d.Set("bound_resource_groups", resp.Data["bound_resource_groups"])
-	}
-
-	if _, ok := d.GetOk("bound_scale_sets"); ok {
-		d.Set("bound_scale_sets", resp.Data["bound_scale_sets"])
+	for _, k := range azureAuthBackendRoleBoundFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on Azure auth backend role %q: %s", k, path, err)
+		}
 	}
 
 	return nil
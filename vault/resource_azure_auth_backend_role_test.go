@@ -28,6 +28,11 @@ func TestAzureAuthBackendRole_basic(t *testing.T) {
 				Config: testAzureAuthBackendRoleConfig_basic(backend, name),
 				Check:  testAzureAuthBackendRoleCheck_attrs(backend, name),
 			},
+			{
+				ResourceName:      "vault_azure_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func azureSecretBackendRoleResource() *schema.Resource {
@@ -98,6 +100,25 @@ func azureSecretBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Human-friendly description of the mount for the backend.",
 			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the applications and service principals created by Vault will be permanently deleted when the corresponding leases expire.",
+			},
+			"sign_in_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the security principal types that are allowed to sign in to the application. Valid values are: AzureADMyOrg, AzureADMultipleOrgs, AzureADandPersonalMicrosoftAccount, PersonalMicrosoftAccount.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Azure tags to attach to an application, in the form of key:value.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -147,6 +168,16 @@ func azureSecretBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 		data["max_ttl"] = v.(string)
 	}
 
+	data["permanently_delete"] = d.Get("permanently_delete").(bool)
+
+	if v, ok := d.GetOk("sign_in_audience"); ok {
+		data["sign_in_audience"] = v.(string)
+	}
+
+	if _, ok := d.GetOk("tags"); ok || d.HasChange("tags") {
+		data["tags"] = util.TerraformSetToStringArray(d.Get("tags"))
+	}
+
 	return nil
 }
 
@@ -197,6 +228,9 @@ func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		"ttl",
 		"max_ttl",
 		"application_object_id",
+		"permanently_delete",
+		"sign_in_audience",
+		"tags",
 	} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
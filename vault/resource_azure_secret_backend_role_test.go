@@ -40,11 +40,16 @@ func TestAzureSecretBackendRole(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.0.role_name", "Reader"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.0.scope"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.0.role_id"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "permanently_delete", "true"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "sign_in_audience", "AzureADMyOrg"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "tags.#", "1"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "tags.0", "team:engineering"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "role", role+"-azure-groups"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "description", "Test for Vault Provider"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.#", "1"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.0.group_name", "foobar"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.0.object_id"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "permanently_delete", "false"),
 				),
 			},
 		},
@@ -91,6 +96,10 @@ resource "vault_azure_secret_backend_role" "test_azure_roles" {
   max_ttl     = 600
   description = "Test for Vault Provider"
 
+  permanently_delete = true
+  sign_in_audience   = "AzureADMyOrg"
+  tags               = ["team:engineering"]
+
   azure_roles {
     role_name = "Reader"
     scope =  "/subscriptions/%[1]s/resourceGroups/%[7]s"
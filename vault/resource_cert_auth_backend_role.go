@@ -10,6 +10,16 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// certAuthBackendRoleOCSPFields are only supported by Vault 1.13 and later,
+// so they are only written when configured and only read when returned.
+var certAuthBackendRoleOCSPFields = []string{
+	"ocsp_enabled",
+	"ocsp_ca_certificates",
+	"ocsp_servers_override",
+	"ocsp_fail_open",
+	"ocsp_query_all_servers",
+}
+
 func certAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"name": {
@@ -78,11 +88,47 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"allowed_metadata_extensions": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+			Computed: true,
+		},
 		"display_name": {
 			Type:     schema.TypeString,
 			Optional: true,
 			Computed: true,
 		},
+		"ocsp_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If enabled, validate certificates' revocation status using OCSP.",
+		},
+		"ocsp_ca_certificates": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Any additional CA certificates needed to verify OCSP responses, PEM encoded.",
+		},
+		"ocsp_servers_override": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Description: "A list of OCSP server addresses to use instead of the ones in the certificates' AIA extension.",
+		},
+		"ocsp_fail_open": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If true and an OCSP response cannot be fetched or is of an unknown status, the login will proceed as if the certificate has not been revoked.",
+		},
+		"ocsp_query_all_servers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If true, all OCSP servers are queried and all must agree on the revocation status.",
+		},
 		"backend": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -149,10 +195,20 @@ func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 		data["required_extensions"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("allowed_metadata_extensions"); ok {
+		data["allowed_metadata_extensions"] = v.(*schema.Set).List()
+	}
+
 	if v, ok := d.GetOk("display_name"); ok {
 		data["display_name"] = v.(string)
 	}
 
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
 	log.Printf("[DEBUG] Writing %q to cert auth backend", path)
 	d.SetId(path)
 	_, err := client.Logical().Write(path, data)
@@ -198,10 +254,20 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["required_extensions"] = v.(*schema.Set).List()
 	}
 
+	if v, ok := d.GetOk("allowed_metadata_extensions"); ok {
+		data["allowed_metadata_extensions"] = v.(*schema.Set).List()
+	}
+
 	if v, ok := d.GetOk("display_name"); ok {
 		data["display_name"] = v.(string)
 	}
 
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
 	log.Printf("[DEBUG] Updating %q in cert auth backend", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -245,6 +311,17 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 				schema.HashString, []interface{}{}))
 	}
 
+	// Vault sometimes returns these as null instead of an empty list.
+	if resp.Data["allowed_common_names"] != nil {
+		d.Set("allowed_common_names",
+			schema.NewSet(
+				schema.HashString, resp.Data["allowed_common_names"].([]interface{})))
+	} else {
+		d.Set("allowed_common_names",
+			schema.NewSet(
+				schema.HashString, []interface{}{}))
+	}
+
 	// Vault sometimes returns these as null instead of an empty list.
 	if resp.Data["allowed_dns_sans"] != nil {
 		d.Set("allowed_dns_sans",
@@ -300,6 +377,25 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 				schema.HashString, []interface{}{}))
 	}
 
+	// Vault sometimes returns these as null instead of an empty list.
+	if resp.Data["allowed_metadata_extensions"] != nil {
+		d.Set("allowed_metadata_extensions",
+			schema.NewSet(
+				schema.HashString, resp.Data["allowed_metadata_extensions"].([]interface{})))
+	} else {
+		d.Set("allowed_metadata_extensions",
+			schema.NewSet(
+				schema.HashString, []interface{}{}))
+	}
+
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on cert %q: %s", k, path, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -95,6 +95,8 @@ func TestCertAuthBackend(t *testing.T) {
 						"token_ttl", "300"),
 					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
 						"token_max_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"token_type", "batch"),
 					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
 						"allowed_names.#", "2"),
 				),
@@ -113,6 +115,8 @@ func TestCertAuthBackend(t *testing.T) {
 						"token_ttl", "0"),
 					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
 						"token_max_ttl", "0"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"token_type", "default"),
 					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
 						"allowed_names.#", "2"),
 				),
@@ -121,6 +125,51 @@ func TestCertAuthBackend(t *testing.T) {
 	})
 }
 
+func TestCertAuthBackend_ocsp(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
+	name := acctest.RandomWithPrefix("tf-test-cert-name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCertAuthBackendCheck_attrs(backend, name),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_enabled", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_fail_open", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_query_all_servers", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_servers_override.#", "2"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_servers_override.0", "http://ocsp-1.example.com"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"allowed_common_names.#", "1"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"allowed_metadata_extensions.#", "1"),
+				),
+			},
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCertAuthBackendCheck_attrs(backend, name),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_enabled", "false"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_fail_open", "false"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_query_all_servers", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testCertAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -177,19 +226,21 @@ func testCertAuthBackendCheck_attrs(backend, name string) resource.TestCheckFunc
 		}
 
 		attrs := map[string]string{
-			"name":                       "display_name",
-			"allowed_names":              "allowed_names",
-			"allowed_dns_sans":           "allowed_dns_sans",
-			"allowed_email_sans":         "allowed_email_sans",
-			"allowed_uri_sans":           "allowed_uri_sans",
-			"allowed_organization_units": "allowed_organization_units",
-			"required_extensions":        "required_extensions",
-			"token_period":               "token_period",
-			"token_policies":             "token_policies",
-			"certificate":                "certificate",
-			"token_ttl":                  "token_ttl",
-			"token_max_ttl":              "token_max_ttl",
-			"token_bound_cidrs":          "token_bound_cidrs",
+			"name":                        "display_name",
+			"allowed_names":               "allowed_names",
+			"allowed_common_names":        "allowed_common_names",
+			"allowed_dns_sans":            "allowed_dns_sans",
+			"allowed_email_sans":          "allowed_email_sans",
+			"allowed_uri_sans":            "allowed_uri_sans",
+			"allowed_organization_units":  "allowed_organization_units",
+			"required_extensions":         "required_extensions",
+			"allowed_metadata_extensions": "allowed_metadata_extensions",
+			"token_period":                "token_period",
+			"token_policies":              "token_policies",
+			"certificate":                 "certificate",
+			"token_ttl":                   "token_ttl",
+			"token_max_ttl":               "token_max_ttl",
+			"token_bound_cidrs":           "token_bound_cidrs",
 		}
 
 		for stateAttr, apiAttr := range attrs {
@@ -289,6 +340,7 @@ __CERTIFICATE__
     token_ttl      = 300
     token_max_ttl  = 600
     token_policies = ["test_policy_1", "test_policy_2"]
+    token_type     = "batch"
 }
 
 `, backend, name, certificate, strings.Join(quotedNames, ", "))
@@ -318,3 +370,28 @@ __CERTIFICATE__
 
 `, backend, name, certificate, strings.Join(quotedNames, ", "))
 }
+
+func testCertAuthBackendConfig_ocsp(backend, name, certificate string, ocsp bool) string {
+	return fmt.Sprintf(`
+
+resource "vault_auth_backend" "cert" {
+    path = "%s"
+    type = "cert"
+}
+
+resource "vault_cert_auth_backend_role" "test" {
+    name                        = "%s"
+    certificate                 = <<__CERTIFICATE__
+%s
+__CERTIFICATE__
+    backend                     = vault_auth_backend.cert.path
+    allowed_common_names        = ["example.com"]
+    allowed_metadata_extensions = ["2.1.1.1"]
+    ocsp_enabled                = %t
+    ocsp_fail_open              = %t
+    ocsp_query_all_servers      = %t
+    ocsp_servers_override       = ["http://ocsp-1.example.com", "http://ocsp-2.example.com"]
+}
+
+`, backend, name, certificate, ocsp, ocsp, ocsp)
+}
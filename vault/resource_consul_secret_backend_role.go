@@ -3,11 +3,15 @@ package vault
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 var (
@@ -55,6 +59,24 @@ func consulSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"service_identities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Set of Consul service identities to attach to the token, " +
+					"formatted as \"<service_name>:<datacenter1>,<datacenter2>\". Applicable for Vault 1.11+ with Consul 1.5+",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"node_identities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Set of Consul node identities to attach to the token, " +
+					"formatted as \"<node_name>:<datacenter>\". Applicable for Vault 1.11+ with Consul 1.8+",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"consul_namespace": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -93,6 +115,15 @@ func consulSecretBackendRoleResource() *schema.Resource {
 				Description: "Indicates that the token should not be replicated globally and instead be local to the current datacenter.",
 				Default:     false,
 			},
+			"create_retry_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+				Description: "Number of times to retry writing the role when the Consul secret backend mount " +
+					"is not yet available (HTTP 404).",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			fieldNamespace: namespaceSchema(),
 		},
 	}
 }
@@ -108,7 +139,10 @@ func consulSecretBackendRoleGetBackend(d *schema.ResourceData) string {
 }
 
 func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Get("name").(string)
 
@@ -121,14 +155,19 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 
 	policies := d.Get("policies").([]interface{})
 	roles := d.Get("consul_roles").(*schema.Set).List()
+	serviceIdentities := d.Get("service_identities").(*schema.Set).List()
+	nodeIdentities := d.Get("node_identities").(*schema.Set).List()
 
-	if len(policies) == 0 && len(roles) == 0 {
-		return fmt.Errorf("policies or consul_roles must be set")
+	if len(policies) == 0 && len(roles) == 0 &&
+		len(serviceIdentities) == 0 && len(nodeIdentities) == 0 {
+		return fmt.Errorf("policies, consul_roles, service_identities or node_identities must be set")
 	}
 
 	data := map[string]interface{}{
-		"policies":     policies,
-		"consul_roles": roles,
+		"policies":           policies,
+		"consul_roles":       roles,
+		"service_identities": serviceIdentities,
+		"node_identities":    nodeIdentities,
 	}
 
 	params := []string{
@@ -147,7 +186,8 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Configuring Consul secrets backend role at %q", path)
 
-	if _, err := client.Logical().Write(path, data); err != nil {
+	retries := d.Get("create_retry_count").(int)
+	if err := consulSecretBackendRoleWriteWithRetry(client, path, data, retries); err != nil {
 		return fmt.Errorf("error writing role configuration for %q: %s", path, err)
 	}
 
@@ -155,8 +195,28 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	return consulSecretBackendRoleRead(d, meta)
 }
 
+// consulSecretBackendRoleWriteWithRetry writes the role data to path,
+// retrying up to retries times while the backend mount returns a 404.
+func consulSecretBackendRoleWriteWithRetry(client *api.Client, path string, data map[string]interface{}, retries int) error {
+	if retries > 0 {
+		var err error
+		client, err = client.Clone()
+		if err != nil {
+			return fmt.Errorf("error cloning client: %w", err)
+		}
+		client.SetMaxRetries(retries)
+		client.SetCheckRetry(util.StatusCheckRetry(http.StatusNotFound))
+	}
+
+	_, err := client.Logical().Write(path, data)
+	return err
+}
+
 func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	upgradeOldID(d)
 
@@ -177,9 +237,10 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Reading Consul secrets backend role at %q", path)
 
-	secret, err := client.Logical().Read(path)
+	// a newly written role may not be visible yet on the node serving the read
+	secret, err := consulSecretBackendRoleReadWithRetry(client, path, d.IsNewResource())
 	if err != nil {
-		return fmt.Errorf("error reading role configuration for %q: %s", path, err)
+		return err
 	}
 
 	if secret == nil {
@@ -202,14 +263,16 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 
 	// map request params to schema fields
 	params := map[string]string{
-		"policies":         "policies",
-		"max_ttl":          "max_ttl",
-		"ttl":              "ttl",
-		"token_type":       "token_type",
-		"local":            "local",
-		"consul_roles":     "consul_roles",
-		"consul_namespace": "consul_namespace",
-		"partition":        "partition",
+		"policies":           "policies",
+		"max_ttl":            "max_ttl",
+		"ttl":                "ttl",
+		"token_type":         "token_type",
+		"local":              "local",
+		"consul_roles":       "consul_roles",
+		"consul_namespace":   "consul_namespace",
+		"partition":          "partition",
+		"service_identities": "service_identities",
+		"node_identities":    "node_identities",
 	}
 
 	for k, v := range params {
@@ -219,6 +282,9 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 			// TODO case this by Vault version (vault-1.10+ request params)
 			case "consul_roles", "consul_namespace", "partition":
 				continue
+			// TODO case this by Vault version (vault-1.11+ request params)
+			case "service_identities", "node_identities":
+				continue
 			}
 		}
 		if err := d.Set(v, val); err != nil {
@@ -229,8 +295,31 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// consulSecretBackendRoleReadWithRetry reads the role at path. When retry is
+// true, 404 and 412 responses are retried up to max_retries_ccc times.
+func consulSecretBackendRoleReadWithRetry(client *api.Client, path string, retry bool) (*api.Secret, error) {
+	if retry {
+		var err error
+		client, err = client.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("error cloning client: %w", err)
+		}
+		util.SetupCCCRetryClient(client, maxHTTPRetriesCCC)
+	}
+
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading role configuration for %q: %s", path, err)
+	}
+
+	return secret, nil
+}
+
 func consulSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
@@ -244,7 +333,10 @@ func consulSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func consulSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return false, err
+	}
 
 	upgradeOldID(d)
 
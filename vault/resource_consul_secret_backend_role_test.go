@@ -2,8 +2,10 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -56,7 +58,7 @@ func TestConsulSecretBackendRole(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testConsulSecretBackendRole_initialConfig(backend, name, token, false, false),
-				ExpectError: regexp.MustCompile(`policies or consul_roles must be set`),
+				ExpectError: regexp.MustCompile(`policies, consul_roles, service_identities or node_identities must be set`),
 			},
 			{
 				Config: testConsulSecretBackendRole_initialConfig(backend, name, token, true, true),
@@ -64,7 +66,7 @@ func TestConsulSecretBackendRole(t *testing.T) {
 			},
 			{
 				Config:      testConsulSecretBackendRole_updateConfig(backend, name, token, false, false),
-				ExpectError: regexp.MustCompile(`policies or consul_roles must be set`),
+				ExpectError: regexp.MustCompile(`policies, consul_roles, service_identities or node_identities must be set`),
 			},
 			{
 				Config: testConsulSecretBackendRole_updateConfig(backend, name, token, true, true),
@@ -74,6 +76,34 @@ func TestConsulSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackendRole_identities(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+
+	resourcePath := "vault_consul_secret_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackendRole_identitiesConfig(backend, name, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "backend", backend),
+					resource.TestCheckResourceAttr(resourcePath, "name", name),
+					resource.TestCheckResourceAttr(resourcePath, "policies.#", "0"),
+					resource.TestCheckResourceAttr(resourcePath, "service_identities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "service_identities.*", "service-0:dc1,dc2"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "service_identities.*", "service-1"),
+					resource.TestCheckResourceAttr(resourcePath, "node_identities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "node_identities.*", "node-0:dc1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -176,6 +206,31 @@ resource "vault_consul_secret_backend_role" "test" {
 	return config + "}"
 }
 
+func testConsulSecretBackendRole_identitiesConfig(backend, name, token string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  address = "127.0.0.1:8500"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%s"
+
+  service_identities = [
+    "service-0:dc1,dc2",
+    "service-1",
+  ]
+
+  node_identities = [
+    "node-0:dc1",
+  ]
+}
+`, backend, token, name)
+}
+
 func TestConsulSecretBackendRoleNameFromPath(t *testing.T) {
 	{
 		name, err := consulSecretBackendRoleNameFromPath("foo/roles/bar")
@@ -219,3 +274,176 @@ func TestConsulSecretBackendRoleBackendFromPath(t *testing.T) {
 		}
 	}
 }
+
+func TestConsulSecretBackendRoleWriteWithRetry(t *testing.T) {
+	tests := []struct {
+		name            string
+		retryHandler    *testRetryHandler
+		retries         int
+		expectedRetries int
+		wantErr         bool
+	}{
+		{
+			name: "no-retry",
+			retryHandler: &testRetryHandler{
+				okAtCount:   2,
+				retryStatus: http.StatusNotFound,
+			},
+			retries:         0,
+			expectedRetries: 0,
+			wantErr:         true,
+		},
+		{
+			name: "retry-ok",
+			retryHandler: &testRetryHandler{
+				okAtCount:   3,
+				retryStatus: http.StatusNotFound,
+			},
+			retries:         3,
+			expectedRetries: 2,
+		},
+		{
+			name: "retry-exhausted",
+			retryHandler: &testRetryHandler{
+				okAtCount:   0,
+				retryStatus: http.StatusNotFound,
+			},
+			retries:         2,
+			expectedRetries: 2,
+			wantErr:         true,
+		},
+		{
+			name: "no-retry-non-404",
+			retryHandler: &testRetryHandler{
+				okAtCount:   2,
+				retryStatus: http.StatusBadRequest,
+			},
+			retries:         3,
+			expectedRetries: 0,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.retryHandler
+
+			config, ln := testutil.TestHTTPServer(t, r.handler())
+			defer ln.Close()
+
+			config.MinRetryWait = time.Millisecond
+			config.MaxRetryWait = time.Millisecond
+			c, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.SetMaxRetries(0)
+
+			err = consulSecretBackendRoleWriteWithRetry(c, "consul/roles/"+tt.name, map[string]interface{}{}, tt.retries)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			retries := r.requests - 1
+			if tt.expectedRetries != retries {
+				t.Fatalf("expected %d retries, actual %d", tt.expectedRetries, retries)
+			}
+		})
+	}
+}
+
+func TestConsulSecretBackendRoleReadWithRetry(t *testing.T) {
+	tests := []struct {
+		name            string
+		retryHandler    *testRetryHandler
+		retry           bool
+		maxRetries      int
+		expectedRetries int
+		wantErr         bool
+	}{
+		{
+			name: "no-retry",
+			retryHandler: &testRetryHandler{
+				okAtCount:   2,
+				retryStatus: http.StatusPreconditionFailed,
+			},
+			retry:           false,
+			expectedRetries: 0,
+			wantErr:         true,
+		},
+		{
+			name: "retry-ok-412",
+			retryHandler: &testRetryHandler{
+				okAtCount:   2,
+				retryStatus: http.StatusPreconditionFailed,
+				respData:    []byte(`{"data": {"policies": ["foo"]}}`),
+			},
+			retry:           true,
+			maxRetries:      2,
+			expectedRetries: 1,
+		},
+		{
+			name: "retry-ok-404",
+			retryHandler: &testRetryHandler{
+				okAtCount:   2,
+				retryStatus: http.StatusNotFound,
+				respData:    []byte(`{"data": {"policies": ["foo"]}}`),
+			},
+			retry:           true,
+			maxRetries:      2,
+			expectedRetries: 1,
+		},
+		{
+			name: "retry-exhausted-412",
+			retryHandler: &testRetryHandler{
+				okAtCount:   0,
+				retryStatus: http.StatusPreconditionFailed,
+			},
+			retry:           true,
+			maxRetries:      2,
+			expectedRetries: 2,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				maxHTTPRetriesCCC = DefaultMaxHTTPRetriesCCC
+			}()
+			maxHTTPRetriesCCC = tt.maxRetries
+
+			r := tt.retryHandler
+
+			config, ln := testutil.TestHTTPServer(t, r.handler())
+			defer ln.Close()
+
+			c, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.SetMaxRetries(0)
+
+			secret, err := consulSecretBackendRoleReadWithRetry(c, "consul/roles/"+tt.name, tt.retry)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if secret == nil {
+					t.Fatal("expected a secret")
+				}
+			}
+
+			retries := r.requests - 1
+			if tt.expectedRetries != retries {
+				t.Fatalf("expected %d retries, actual %d", tt.expectedRetries, retries)
+			}
+		})
+	}
+}
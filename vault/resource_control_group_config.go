@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	controlGroupConfigPath = "sys/config/control-group"
+	controlGroupConfigID   = "control-group"
+)
+
+func controlGroupConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: controlGroupConfigWrite,
+		Update: controlGroupConfigWrite,
+		Read:   controlGroupConfigRead,
+		Delete: controlGroupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The maximum TTL in seconds for a control group wrapping token.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func controlGroupConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"max_ttl": d.Get("max_ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Writing control group config")
+	if _, err := client.Logical().Write(controlGroupConfigPath, data); err != nil {
+		return fmt.Errorf("error writing control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote control group config")
+
+	d.SetId(controlGroupConfigID)
+
+	return controlGroupConfigRead(d, meta)
+}
+
+func controlGroupConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading control group config")
+	resp, err := client.Logical().Read(controlGroupConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Read control group config")
+
+	if resp == nil {
+		log.Printf("[WARN] Control group config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := resp.Data["max_ttl"].(json.Number); ok {
+		maxTTL, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected max_ttl %q to be a number, and it isn't", v)
+		}
+		if err := d.Set("max_ttl", maxTTL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func controlGroupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Deleting control group config")
+	if _, err := client.Logical().Delete(controlGroupConfigPath); err != nil {
+		return fmt.Errorf("error deleting control group config: %s", err)
+	}
+	log.Printf("[DEBUG] Deleted control group config")
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccControlGroupConfig(t *testing.T) {
+	resourceName := "vault_control_group_config.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccControlGroupConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlGroupConfig(3600),
+				Check:  resource.TestCheckResourceAttr(resourceName, "max_ttl", "3600"),
+			},
+			{
+				Config: testAccControlGroupConfig(7200),
+				Check:  resource.TestCheckResourceAttr(resourceName, "max_ttl", "7200"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccControlGroupConfigCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_control_group_config" {
+			continue
+		}
+		resp, err := client.Logical().Read(controlGroupConfigPath)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			if v, ok := resp.Data["max_ttl"]; ok && fmt.Sprint(v) != "0" {
+				return fmt.Errorf("control group config still exists, max_ttl=%v", v)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccControlGroupConfig(maxTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_control_group_config" "test" {
+  max_ttl = %d
+}`, maxTTL)
+}
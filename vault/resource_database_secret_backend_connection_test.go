@@ -691,6 +691,7 @@ func TestAccDatabaseSecretBackendConnection_postgresql(t *testing.T) {
 					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "postgresql.0.username", username),
 					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "postgresql.0.disable_escaping", "true"),
 					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "postgresql.0.username_template", userTempl),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "password_policy", name),
 				),
 			},
 		},
@@ -1311,11 +1312,22 @@ resource "vault_mount" "db" {
   type = "database"
 }
 
+resource "vault_password_policy" "db" {
+  name   = "%s"
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcdefghijklmnopqrstuvwxyz0123456789"
+}
+EOT
+}
+
 resource "vault_database_secret_backend_connection" "test" {
   backend = vault_mount.db.path
   name = "%s"
   allowed_roles = ["dev", "prod"]
   root_rotation_statements = ["FOOBAR"]
+  password_policy = vault_password_policy.db.name
 
   postgresql {
 	  connection_url    = "%s"
@@ -1325,7 +1337,7 @@ resource "vault_database_secret_backend_connection" "test" {
       disable_escaping  = true
   }
 }
-`, path, name, parsedURL.String(), parsedURL.User.Username(), password, userTempl)
+`, path, name, name, parsedURL.String(), parsedURL.User.Username(), password, userTempl)
 }
 
 func testAccDatabaseSecretBackendConnectionConfig_snowflake(name, path, url, username, password, userTempl string) string {
@@ -1804,3 +1816,41 @@ func Test_getDBEngineFromResp(t *testing.T) {
 		})
 	}
 }
+
+func Test_getConnectionDetailsMongoDBAtlas(t *testing.T) {
+	prefix := dbEngineMongoDBAtlas.Name() + ".0."
+	d := schema.TestResourceDataRaw(
+		t,
+		getDatabaseSchema(schema.TypeList),
+		map[string]interface{}{
+			"name":    "atlas",
+			"backend": "database",
+			dbEngineMongoDBAtlas.Name(): []interface{}{
+				map[string]interface{}{
+					"public_key":  "old-public",
+					"private_key": "super-secret",
+					"project_id":  "old-project",
+				},
+			},
+		},
+	)
+
+	// Vault never returns the private key
+	resp := &api.Secret{
+		Data: map[string]interface{}{
+			"connection_details": map[string]interface{}{
+				"public_key": "public",
+				"project_id": "project",
+			},
+		},
+	}
+
+	want := map[string]interface{}{
+		"public_key":  "public",
+		"private_key": "super-secret",
+		"project_id":  "project",
+	}
+	if got := getConnectionDetailsMongoDBAtlas(d, prefix, resp); !reflect.DeepEqual(got, want) {
+		t.Errorf("getConnectionDetailsMongoDBAtlas() got = %v, want %v", got, want)
+	}
+}
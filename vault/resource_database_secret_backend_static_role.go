@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -47,9 +48,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -58,6 +60,21 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 					return
 				},
 			},
+			"rotation_schedule": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description: "A cron-style string that will define the schedule on which rotations should occur. " +
+					"Mutually exclusive with rotation_period.",
+			},
+			"rotation_window": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description: "The amount of time, in seconds, in which rotations are allowed to occur starting " +
+					"from a given rotation_schedule.",
+				ValidateFunc: validation.IntAtLeast(3600),
+			},
 			"db_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -84,11 +101,34 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
 
+	if v, ok := d.GetOk("rotation_period"); ok {
+		data["rotation_period"] = v
+	}
+
+	if v, ok := d.GetOk("rotation_schedule"); ok {
+		data["rotation_schedule"] = v
+		if v, ok := d.GetOk("rotation_window"); ok {
+			data["rotation_window"] = v
+		}
+	}
+
+	// rotation_period and rotation_schedule are mutually exclusive, when
+	// switching from one to the other explicitly clear the previous one,
+	// otherwise Vault keeps it active.
+	if !d.IsNewResource() {
+		if _, ok := data["rotation_period"]; !ok && d.HasChange("rotation_period") {
+			data["rotation_period"] = 0
+		}
+		if _, ok := data["rotation_schedule"]; !ok && d.HasChange("rotation_schedule") {
+			data["rotation_schedule"] = ""
+			data["rotation_window"] = 0
+		}
+	}
+
 	if v, ok := d.GetOkExists("rotation_statements"); ok && v != "" {
 		data["rotation_statements"] = v
 	}
@@ -148,6 +188,18 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 		d.Set("rotation_period", n)
 	}
 
+	if v, ok := role.Data["rotation_schedule"]; ok {
+		d.Set("rotation_schedule", v)
+	}
+
+	if v, ok := role.Data["rotation_window"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for rotation_window of %q", v, path)
+		}
+		d.Set("rotation_window", n)
+	}
+
 	var rotation []string
 	if rotationStr, ok := role.Data["rotation_statements"].(string); ok {
 		rotation = append(rotation, rotationStr)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -15,6 +16,14 @@ const (
 	gcpAuthDefaultPath = "gcp"
 )
 
+// gcpIdentityTokenFields are the workload identity federation fields shared
+// by the GCP auth and secrets engine configs.
+var gcpIdentityTokenFields = []string{
+	"identity_token_audience",
+	"identity_token_ttl",
+	"service_account_email",
+}
+
 func gcpAuthBackendResource() *schema.Resource {
 	return &schema.Resource{
 
@@ -73,6 +82,23 @@ func gcpAuthBackendResource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies if the auth method is local only",
 			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens, used for workload identity federation. Requires Vault 1.17+ Enterprise.",
+			},
+			"identity_token_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL in seconds of generated plugin identity tokens. Requires Vault 1.17+ Enterprise.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service account to impersonate when using workload identity federation. Requires Vault 1.17+ Enterprise.",
+			},
 		},
 	}
 }
@@ -108,6 +134,38 @@ func NormalizeCredentials(configI interface{}) string {
 	return string(ret)
 }
 
+// gcpIdentityTokenData adds the changed workload identity federation fields
+// to data.
+func gcpIdentityTokenData(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range gcpIdentityTokenFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+}
+
+// readGCPIdentityTokenFields sets the workload identity federation fields
+// from resp, skipping any that Vault does not return.
+func readGCPIdentityTokenFields(d *schema.ResourceData, resp *api.Secret) error {
+	for _, k := range gcpIdentityTokenFields {
+		v, ok := resp.Data[k]
+		if !ok {
+			continue
+		}
+		if n, ok := v.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s %q to be a number, and it isn't", k, n)
+			}
+			v = i
+		}
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func gcpAuthBackendConfigPath(path string) string {
 	return "auth/" + strings.Trim(path, "/") + "/config"
 }
@@ -145,6 +203,7 @@ func gcpAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("credentials"); ok {
 		data["credentials"] = v.(string)
 	}
+	gcpIdentityTokenData(d, data)
 
 	log.Printf("[DEBUG] Writing gcp config %q", path)
 	_, err := client.Logical().Write(path, data)
@@ -189,6 +248,10 @@ func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := readGCPIdentityTokenFields(d, resp); err != nil {
+		return err
+	}
+
 	// set the auth backend's path
 	if err := d.Set("path", d.Id()); err != nil {
 		return err
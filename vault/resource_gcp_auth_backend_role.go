@@ -7,10 +7,19 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/vault/api"
 )
 
+// gcpAuthBackendRoleGCEFields are only returned by Vault for roles of type
+// gce.
+var gcpAuthBackendRoleGCEFields = []string{
+	"bound_zones",
+	"bound_regions",
+	"bound_instance_groups",
+}
+
 var (
 	gcpAuthBackendFromPathRegex  = regexp.MustCompile("^auth/(.+)/role/[^/]+$")
 	gcpAuthRoleNameFromPathRegex = regexp.MustCompile("^auth/.+/role/([^/]+)$")
@@ -24,9 +33,10 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 			ForceNew: true,
 		},
 		"type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"iam", "gce"}, false),
 		},
 		"bound_projects": {
 			Type: schema.TypeSet,
@@ -237,7 +247,19 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	readTokenFields(d, resp)
 
-	for _, k := range []string{"bound_projects", "add_group_aliases", "max_jwt_exp", "bound_service_accounts", "bound_zones", "bound_regions", "bound_instance_groups"} {
+	// These checks are done for backwards compatibility. The 'type' key used to be
+	// 'role_type' and was changed to 'role' errorneously before being corrected
+	var roleType interface{}
+	if v, ok := resp.Data["type"]; ok {
+		roleType = v
+	} else if v, ok := resp.Data["role_type"]; ok {
+		roleType = v
+	} else if v, ok := resp.Data["role"]; ok {
+		roleType = v
+	}
+	d.Set("type", roleType)
+
+	for _, k := range []string{"bound_projects", "add_group_aliases", "max_jwt_exp", "allow_gce_inference", "bound_service_accounts"} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
 				return fmt.Errorf("error reading %s for GCP Auth Backend Role %q: %q", k, path, err)
@@ -245,25 +267,25 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if v, ok := resp.Data["bound_labels"]; ok {
-		labels := []string{}
-		for labelK, labelV := range v.(map[string]interface{}) {
-			labels = append(labels, fmt.Sprintf("%s:%s", labelK, labelV))
+	if roleType == "gce" {
+		for _, k := range gcpAuthBackendRoleGCEFields {
+			if v, ok := resp.Data[k]; ok {
+				if err := d.Set(k, v); err != nil {
+					return fmt.Errorf("error reading %s for GCP Auth Backend Role %q: %q", k, path, err)
+				}
+			}
 		}
 
-		if err := d.Set("bound_labels", labels); err != nil {
-			return fmt.Errorf("error setting bound_labels for GCP auth backend role: %q", err)
-		}
-	}
+		if v, ok := resp.Data["bound_labels"]; ok {
+			labels := []string{}
+			for labelK, labelV := range v.(map[string]interface{}) {
+				labels = append(labels, fmt.Sprintf("%s:%s", labelK, labelV))
+			}
 
-	// These checks are done for backwards compatibility. The 'type' key used to be
-	// 'role_type' and was changed to 'role' errorneously before being corrected
-	if v, ok := resp.Data["type"]; ok {
-		d.Set("type", v)
-	} else if v, ok := resp.Data["role_type"]; ok {
-		d.Set("type", v)
-	} else if v, ok := resp.Data["role"]; ok {
-		d.Set("type", v)
+			if err := d.Set("bound_labels", labels); err != nil {
+				return fmt.Errorf("error setting bound_labels for GCP auth backend role: %q", err)
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,163 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var gcpAuthBackendRoleServiceAccountIDRegex = regexp.MustCompile("^auth/(.+)/role/([^/]+)/service-accounts/([^/]+)$")
+
+// gcpAuthBackendRoleServiceAccountResource manages a single entry of a GCP
+// auth role's bound_service_accounts, leaving the other entries untouched.
+func gcpAuthBackendRoleServiceAccountResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpAuthBackendRoleServiceAccountCreate,
+		Read:   gcpAuthBackendRoleServiceAccountRead,
+		Delete: gcpAuthBackendRoleServiceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the GCP auth role.",
+			},
+			"service_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service account to bind to the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "gcp",
+				Description: "Path to the mounted GCP auth backend.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+		},
+	}
+}
+
+func gcpAuthBackendRoleServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	serviceAccount := d.Get("service_account").(string)
+	rolePath := gcpRoleResourcePath(backend, role)
+
+	log.Printf("[DEBUG] Adding service account %q to GCP auth role %q", serviceAccount, rolePath)
+	if err := gcpAuthBackendRoleEditServiceAccounts(client, rolePath, "add", serviceAccount); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Added service account %q to GCP auth role %q", serviceAccount, rolePath)
+
+	d.SetId(rolePath + "/service-accounts/" + serviceAccount)
+
+	return gcpAuthBackendRoleServiceAccountRead(d, meta)
+}
+
+func gcpAuthBackendRoleServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend, role, serviceAccount, err := gcpAuthBackendRoleServiceAccountParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	rolePath := gcpRoleResourcePath(backend, role)
+
+	log.Printf("[DEBUG] Reading GCP auth role %q", rolePath)
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP auth role %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Read GCP auth role %q", rolePath)
+
+	if resp == nil {
+		log.Printf("[WARN] GCP auth role %q not found, removing service account %q from state", rolePath, serviceAccount)
+		d.SetId("")
+		return nil
+	}
+
+	serviceAccounts, _ := resp.Data["bound_service_accounts"].([]interface{})
+	if found, _ := util.SliceHasElement(serviceAccounts, serviceAccount); !found {
+		log.Printf("[WARN] Service account %q not bound to GCP auth role %q, removing from state", serviceAccount, rolePath)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", backend); err != nil {
+		return err
+	}
+	if err := d.Set("role", role); err != nil {
+		return err
+	}
+	if err := d.Set("service_account", serviceAccount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func gcpAuthBackendRoleServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend, role, serviceAccount, err := gcpAuthBackendRoleServiceAccountParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	rolePath := gcpRoleResourcePath(backend, role)
+
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP auth role %q: %s", rolePath, err)
+	}
+	if resp == nil {
+		// The role is already gone, and the service account with it.
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing service account %q from GCP auth role %q", serviceAccount, rolePath)
+	if err := gcpAuthBackendRoleEditServiceAccounts(client, rolePath, "remove", serviceAccount); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Removed service account %q from GCP auth role %q", serviceAccount, rolePath)
+
+	return nil
+}
+
+// gcpAuthBackendRoleEditServiceAccounts uses the role's dedicated
+// service-accounts endpoint, so that Vault applies the change atomically
+// rather than us rewriting the whole list.
+func gcpAuthBackendRoleEditServiceAccounts(client *api.Client, rolePath, op, serviceAccount string) error {
+	path := rolePath + "/service-accounts"
+	data := map[string]interface{}{
+		op: []string{serviceAccount},
+	}
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error editing service accounts of GCP auth role %q: %s", rolePath, err)
+	}
+	return nil
+}
+
+func gcpAuthBackendRoleServiceAccountParseID(id string) (string, string, string, error) {
+	res := gcpAuthBackendRoleServiceAccountIDRegex.FindStringSubmatch(id)
+	if len(res) != 4 {
+		return "", "", "", fmt.Errorf("invalid ID %q for GCP auth role service account, expected auth/<backend>/role/<role>/service-accounts/<service_account>", id)
+	}
+	return res[1], res[2], res[3], nil
+}
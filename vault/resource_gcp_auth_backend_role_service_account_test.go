@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestGCPAuthBackendRoleServiceAccount_parseID(t *testing.T) {
+	backend, role, serviceAccount, err := gcpAuthBackendRoleServiceAccountParseID(
+		"auth/gcp/nested/role/my-role/service-accounts/sa@project.iam.gserviceaccount.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "gcp/nested" || role != "my-role" || serviceAccount != "sa@project.iam.gserviceaccount.com" {
+		t.Fatalf("unexpected result %q, %q, %q", backend, role, serviceAccount)
+	}
+
+	if _, _, _, err := gcpAuthBackendRoleServiceAccountParseID("auth/gcp/role/my-role"); err == nil {
+		t.Fatal("expected an error for an ID without a service account")
+	}
+}
+
+func TestGCPAuthBackendRoleServiceAccount_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp-backend")
+	name := acctest.RandomWithPrefix("tf-test-gcp-role")
+	resourceName := "vault_gcp_auth_backend_role_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testGCPAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPAuthBackendRoleServiceAccountConfig(backend, name, "team-b@project.iam.gserviceaccount.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role", name),
+					resource.TestCheckResourceAttr(resourceName, "service_account", "team-b@project.iam.gserviceaccount.com"),
+					testGCPAuthBackendRoleServiceAccountCheck(backend, name,
+						"team-a@project.iam.gserviceaccount.com", "team-b@project.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: testGCPAuthBackendRoleServiceAccountConfig(backend, name, "team-c@project.iam.gserviceaccount.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_account", "team-c@project.iam.gserviceaccount.com"),
+					testGCPAuthBackendRoleServiceAccountCheck(backend, name,
+						"team-a@project.iam.gserviceaccount.com", "team-c@project.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGCPAuthBackendRoleServiceAccountCheck(backend, name string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		path := gcpRoleResourcePath(backend, name)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading GCP auth role %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("GCP auth role %q not found", path)
+		}
+
+		serviceAccounts, _ := resp.Data["bound_service_accounts"].([]interface{})
+		if len(serviceAccounts) != len(expected) {
+			return fmt.Errorf("expected %d bound service accounts, got %v", len(expected), serviceAccounts)
+		}
+		for _, sa := range expected {
+			if found, _ := util.SliceHasElement(serviceAccounts, sa); !found {
+				return fmt.Errorf("expected service account %q to be bound, got %v", sa, serviceAccounts)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testGCPAuthBackendRoleServiceAccountConfig(backend, name, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "gcp" {
+  path = "%s"
+  type = "gcp"
+}
+
+resource "vault_gcp_auth_backend_role" "test" {
+  backend                = vault_auth_backend.gcp.path
+  role                   = "%s"
+  type                   = "iam"
+  bound_service_accounts = ["team-a@project.iam.gserviceaccount.com"]
+
+  lifecycle {
+    ignore_changes = [bound_service_accounts]
+  }
+}
+
+resource "vault_gcp_auth_backend_role_service_account" "test" {
+  backend         = vault_auth_backend.gcp.path
+  role            = vault_gcp_auth_backend_role.test.role
+  service_account = "%s"
+}
+`, backend, name, serviceAccount)
+}
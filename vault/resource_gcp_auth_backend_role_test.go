@@ -130,6 +130,11 @@ func TestGCPAuthBackendRole_gce(t *testing.T) {
 						"bound_labels.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "vault_gcp_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -66,6 +66,32 @@ func TestGCPAuthBackend_import(t *testing.T) {
 	})
 }
 
+func TestGCPAuthBackend_identityToken(t *testing.T) {
+	path := resource.PrefixedUniqueId("gcp-wif-")
+	resourceName := "vault_gcp_auth_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testGCPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPAuthBackendConfig_identityToken(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault.example/v1/identity/oidc"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", "vault@example.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testGCPAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -113,3 +139,14 @@ resource "vault_gcp_auth_backend" "test" {
 }
 `, credentials, path)
 }
+
+func testGCPAuthBackendConfig_identityToken(path string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_auth_backend" "test" {
+  path                    = %q
+  identity_token_audience = "vault.example/v1/identity/oidc"
+  identity_token_ttl      = 600
+  service_account_email   = "vault@example.iam.gserviceaccount.com"
+}
+`, path)
+}
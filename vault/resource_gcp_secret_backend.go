@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -76,6 +77,23 @@ func gcpSecretBackendResource(name string) *schema.Resource {
 				ForceNew:    true,
 				Description: "Local mount flag that can be explicitly set to true to enforce local mount in HA environment",
 			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of plugin identity tokens, used for workload identity federation. Requires Vault 1.17+ Enterprise.",
+			},
+			"identity_token_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL in seconds of generated plugin identity tokens. Requires Vault 1.17+ Enterprise.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service account to impersonate when using workload identity federation. Requires Vault 1.17+ Enterprise.",
+			},
 		},
 	}
 }
@@ -110,15 +128,17 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(path)
 
 	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
+	data := map[string]interface{}{}
 	if credentials != "" {
-		data := map[string]interface{}{
-			"credentials": credentials,
-		}
+		data["credentials"] = credentials
+	}
+	gcpIdentityTokenData(d, data)
+	if len(data) > 0 {
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
 	} else {
-		log.Printf("[DEBUG] No credentials configured")
+		log.Printf("[DEBUG] No configuration provided")
 	}
 	log.Printf("[DEBUG] Wrote GCP configuration to %q", configPath)
 	d.Partial(false)
@@ -153,6 +173,20 @@ func gcpSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 	d.Set("local", mount.Local)
 
+	// credentials are never returned by Vault.
+	configPath := gcpSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading GCP configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP configuration from %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read GCP configuration from %q", configPath)
+	if resp != nil {
+		if err := readGCPIdentityTokenFields(d, resp); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -174,15 +208,18 @@ func gcpSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
 	}
 
+	data := map[string]interface{}{}
 	if d.HasChange("credentials") {
-		data := map[string]interface{}{
-			"credentials": d.Get("credentials"),
-		}
+		data["credentials"] = d.Get("credentials")
+	}
+	gcpIdentityTokenData(d, data)
+	if len(data) > 0 {
 		configPath := gcpSecretBackendConfigPath(path)
+		log.Printf("[DEBUG] Updating GCP configuration for %q", path)
 		if _, err := client.Logical().Write(configPath, data); err != nil {
-			return fmt.Errorf("error writing GCP credentials for %q: %s", path, err)
+			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
-		log.Printf("[DEBUG] Updated credentials for %q", path)
+		log.Printf("[DEBUG] Updated GCP configuration for %q", path)
 	}
 
 	d.Partial(false)
@@ -46,6 +46,32 @@ func TestGCPSecretBackend(t *testing.T) {
 	})
 }
 
+func TestGCPSecretBackend_identityToken(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-gcp")
+	resourceName := "vault_gcp_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccGCPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretBackend_identityTokenConfig(path, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault.example/v1/identity/oidc"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", "vault@example.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: testGCPSecretBackend_identityTokenConfig(path, 1200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "1200"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGCPSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -98,3 +124,13 @@ EOF
   local = true
 }`, path)
 }
+
+func testGCPSecretBackend_identityTokenConfig(path string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path                    = "%s"
+  identity_token_audience = "vault.example/v1/identity/oidc"
+  identity_token_ttl      = %d
+  service_account_email   = "vault@example.iam.gserviceaccount.com"
+}`, path, ttl)
+}
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+	"golang.org/x/oauth2/google"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// This test requires that you pass credentials for a service account that is
+// allowed to generate access tokens for itself (roles/iam.serviceAccountTokenCreator).
+func TestGCPSecretImpersonatedAccount(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	impersonatedAccount := acctest.RandomWithPrefix("tf-test")
+	credentials, project := testutil.GetTestGCPCreds(t)
+
+	// We will use the provided key as the impersonated account
+	conf, err := google.JWTConfigFromJSON([]byte(credentials), "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		t.Fatalf("error decoding GCP Credentials: %v", err)
+	}
+	serviceAccountEmail := conf.Email
+
+	resourceName := "vault_gcp_secret_impersonated_account.test"
+	dataSourceName := "data.vault_gcp_secret_impersonated_account_token.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testGCPSecretImpersonatedAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, serviceAccountEmail, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/impersonated-account/"+impersonatedAccount),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "impersonated_account", impersonatedAccount),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", serviceAccountEmail),
+					resource.TestCheckResourceAttr(resourceName, "service_account_project", project),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "token_scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_scopes.*", "https://www.googleapis.com/auth/cloud-platform"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expires_at_seconds"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, serviceAccountEmail, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testGCPSecretImpersonatedAccountDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_impersonated_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for GCP Secrets ImpersonatedAccount %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP Secrets ImpersonatedAccount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, serviceAccountEmail string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_impersonated_account" "test" {
+  backend               = vault_gcp_secret_backend.test.path
+  impersonated_account  = "%s"
+  token_scopes          = ["https://www.googleapis.com/auth/cloud-platform"]
+  ttl                   = %d
+  service_account_email = "%s"
+}
+
+data "vault_gcp_secret_impersonated_account_token" "test" {
+  backend              = vault_gcp_secret_impersonated_account.test.backend
+  impersonated_account = vault_gcp_secret_impersonated_account.test.impersonated_account
+}
+`, backend, credentials, impersonatedAccount, ttl, serviceAccountEmail)
+}
@@ -67,6 +67,15 @@ func genericSecretResource(name string) *schema.Resource {
 				Default:     false,
 				Description: "Only applicable for kv-v2 stores. If set, permanently deletes all versions for the specified key.",
 			},
+
+			"merge_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set, the keys in data_json are merged into the existing secret, " +
+					"preserving any keys that are not managed by Terraform. " +
+					"On destroy only the managed keys are removed.",
+			},
 		},
 	}
 }
@@ -127,7 +136,44 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	}
 
 	path := d.Get("path").(string)
-	originalPath := path // if the path belongs to a v2 endpoint, it will be modified
+
+	if d.Get("merge_keys").(bool) {
+		vaultMutexKV.Lock(path)
+		defer vaultMutexKV.Unlock(path)
+
+		existing, err := genericSecretExistingData(client, path)
+		if err != nil {
+			return err
+		}
+
+		// remove the keys that are no longer managed by Terraform
+		if !d.IsNewResource() && d.HasChange("data_json") {
+			o, _ := d.GetChange("data_json")
+			for k := range genericSecretManagedKeys(o.(string)) {
+				if _, ok := data[k]; !ok {
+					delete(existing, k)
+				}
+			}
+		}
+
+		for k, v := range data {
+			existing[k] = v
+		}
+		data = existing
+	}
+
+	if err := genericSecretWriteData(client, path, data); err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return genericSecretResourceRead(d, meta)
+}
+
+// genericSecretWriteData writes data to path, wrapping it as required when
+// the path belongs to a KV-V2 mount.
+func genericSecretWriteData(client *api.Client, path string, data map[string]interface{}) error {
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
 		return fmt.Errorf("error determining if it's a v2 path: %s", err)
@@ -148,9 +194,71 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
-	d.SetId(originalPath)
+	return nil
+}
 
-	return genericSecretResourceRead(d, meta)
+// genericSecretExistingData returns a copy of the latest secret data stored
+// at path, or an empty map if no secret exists.
+func genericSecretExistingData(client *api.Client, path string) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+
+	_, v2, err := isKVv2(path, client)
+	if err != nil {
+		return nil, fmt.Errorf("error determining if it's a v2 path: %s", err)
+	}
+
+	secret, err := versionedSecret(latestSecretVersion, path, client)
+	if err != nil {
+		return nil, fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	if secret != nil && v2 && genericSecretIsDeletedV2(secret) {
+		// the latest version is soft-deleted or destroyed, versionedSecret
+		// leaves the data/metadata wrapper in place in that case
+		return result, nil
+	}
+
+	if secret != nil {
+		for k, v := range secret.Data {
+			result[k] = v
+		}
+	}
+
+	return result, nil
+}
+
+// genericSecretIsDeletedV2 reports whether secret is the response for a
+// KV-V2 secret whose latest version has been deleted.
+func genericSecretIsDeletedV2(secret *api.Secret) bool {
+	data, ok := secret.Data["data"]
+	if !ok {
+		return false
+	}
+	if data == nil {
+		return true
+	}
+	if metadata, ok := secret.Data["metadata"].(map[string]interface{}); ok {
+		if v, ok := metadata["deletion_time"].(string); ok && v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// genericSecretManagedKeys returns the set of top-level keys in dataJSON.
+func genericSecretManagedKeys(dataJSON string) map[string]bool {
+	keys := map[string]bool{}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataJSON), &data); err != nil {
+		return keys
+	}
+
+	for k := range data {
+		keys[k] = true
+	}
+
+	return keys
 }
 
 func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -158,6 +266,26 @@ func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error
 
 	path := d.Id()
 
+	if d.Get("merge_keys").(bool) {
+		vaultMutexKV.Lock(path)
+		defer vaultMutexKV.Unlock(path)
+
+		existing, err := genericSecretExistingData(client, path)
+		if err != nil {
+			return err
+		}
+
+		for k := range genericSecretManagedKeys(d.Get("data_json").(string)) {
+			delete(existing, k)
+		}
+
+		// only remove the managed keys if there are unmanaged keys left over
+		if len(existing) > 0 {
+			log.Printf("[DEBUG] Removing managed keys from vault_generic_secret %q", path)
+			return genericSecretWriteData(client, path, existing)
+		}
+	}
+
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
 		return fmt.Errorf("error determining if it's a v2 path: %s", err)
@@ -204,7 +332,17 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] secret: %#v", secret)
 
 		data = secret.Data
-		jsonData, err := json.Marshal(secret.Data)
+		if d.Get("merge_keys").(bool) {
+			// only track the keys that are managed by Terraform
+			managed := genericSecretManagedKeys(d.Get("data_json").(string))
+			data = map[string]interface{}{}
+			for k, v := range secret.Data {
+				if managed[k] {
+					data[k] = v
+				}
+			}
+		}
+		jsonData, err := json.Marshal(data)
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
 		}
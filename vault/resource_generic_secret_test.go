@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -100,6 +101,165 @@ func TestResourceGenericSecret_deleteAllVersions(t *testing.T) {
 	})
 }
 
+func TestResourceGenericSecret_mergeKeys(t *testing.T) {
+	path := acctest.RandomWithPrefix("secretsv1/test")
+	resourceName := "vault_generic_secret.test"
+
+	checkRemote := func(want map[string]interface{}) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			client := testProvider.Meta().(*api.Client)
+			secret, err := client.Logical().Read(path)
+			if err != nil {
+				return err
+			}
+			if secret == nil {
+				return fmt.Errorf("secret %q not found", path)
+			}
+			if !reflect.DeepEqual(want, secret.Data) {
+				return fmt.Errorf("expected secret data %#v, actual %#v", want, secret.Data)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_mergeKeysConfig(path, "zap", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "merge_keys", "true"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(path, map[string]interface{}{
+						"zip":   "zap",
+						"other": "unmanaged",
+					})
+					if err != nil {
+						t.Fatalf("unable to write the unmanaged key via the SDK: %s", err)
+					}
+				},
+				Config: testResourceGenericSecret_mergeKeysConfig(path, "zoop", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zoop"),
+					checkRemote(map[string]interface{}{
+						"zip":   "zoop",
+						"other": "unmanaged",
+					}),
+				),
+			},
+			{
+				Config: testResourceGenericSecret_mergeKeysConfig(path, "", false),
+				Check: checkRemote(map[string]interface{}{
+					"other": "unmanaged",
+				}),
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_mergeKeysDeletedV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	path := mount + "/test"
+	resourceName := "vault_generic_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_mergeKeysConfigV2(mount, path),
+				Check:  resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if _, err := client.Logical().Delete(mount + "/data/test"); err != nil {
+						t.Fatalf("unable to soft-delete the secret via the SDK: %s", err)
+					}
+				},
+				Config: testResourceGenericSecret_mergeKeysConfigV2(mount, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					func(s *terraform.State) error {
+						client := testProvider.Meta().(*api.Client)
+						secret, err := client.Logical().Read(mount + "/data/test")
+						if err != nil {
+							return err
+						}
+						if secret == nil {
+							return fmt.Errorf("secret %q not found", path)
+						}
+						want := map[string]interface{}{"zip": "zap"}
+						if !reflect.DeepEqual(want, secret.Data["data"]) {
+							return fmt.Errorf("expected secret data %#v, actual %#v", want, secret.Data["data"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_mergeKeysConfigV2(mount, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v2" {
+	path = "%s"
+	type = "kv"
+	options = {
+		version = "2"
+	}
+}
+
+resource "vault_generic_secret" "test" {
+	path = "%s"
+	merge_keys = true
+	data_json = <<EOT
+{
+	"zip": "zap"
+}
+EOT
+
+	depends_on = [vault_mount.v2]
+}
+`, mount, path)
+}
+
+func testResourceGenericSecret_mergeKeysConfig(path, value string, withSecret bool) string {
+	result := `
+resource "vault_mount" "v1" {
+	path = "secretsv1"
+	type = "kv"
+	options = {
+		version = "1"
+	}
+}
+`
+	if withSecret {
+		result += fmt.Sprintf(`
+resource "vault_generic_secret" "test" {
+	depends_on = ["vault_mount.v1"]
+	path = "%s"
+	merge_keys = true
+	data_json = <<EOT
+{
+	"zip": "%s"
+}
+EOT
+}`, path, value)
+	}
+
+	return result
+}
+
 func testResourceGenericSecret_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "v1" {
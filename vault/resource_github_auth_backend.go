@@ -181,7 +181,7 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading tune information from Vault: %w", err)
 	}
 
-	data := getCommonTokenFieldMap(resp)
+	data := githubAuthBackendTokenFieldMap(resp)
 	data["path"] = d.Id()
 	data["organization"] = resp.Data["organization"]
 	data["base_url"] = resp.Data["base_url"]
@@ -200,6 +200,26 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// githubAuthBackendTokenFieldMap returns the common token fields from the
+// github config, falling back to the legacy ttl and max_ttl fields returned
+// by older versions of Vault.
+func githubAuthBackendTokenFieldMap(resp *api.Secret) map[string]interface{} {
+	data := getCommonTokenFieldMap(resp)
+	for tokenField, legacyField := range map[string]string{
+		TokenFieldTTL:    "ttl",
+		TokenFieldMaxTTL: "max_ttl",
+	} {
+		if _, ok := resp.Data[tokenField]; ok {
+			continue
+		}
+		if v, ok := resp.Data[legacyField]; ok {
+			data[tokenField] = v
+		}
+	}
+
+	return data
+}
+
 func githubAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	return authMountDisable(meta.(*api.Client), d.Id())
 }
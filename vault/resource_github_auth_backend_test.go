@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -57,6 +58,10 @@ func TestAccGithubAuthBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "organization_id", "2999"),
 					resource.TestCheckResourceAttr(resName, "token_ttl", "2400"),
 					resource.TestCheckResourceAttr(resName, "token_max_ttl", "6000"),
+					resource.TestCheckResourceAttr(resName, "token_num_uses", "10"),
+					resource.TestCheckResourceAttr(resName, "token_policies.#", "2"),
+					resource.TestCheckResourceAttr(resName, "token_bound_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resName, "token_bound_cidrs.0", "10.0.0.0/8"),
 					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuth.Accessor),
 				),
 			},
@@ -64,6 +69,57 @@ func TestAccGithubAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestGithubAuthBackendTokenFieldMap(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "token-fields",
+			data: map[string]interface{}{
+				TokenFieldTTL:    1200,
+				TokenFieldMaxTTL: 3000,
+				"ttl":            1200,
+				"max_ttl":        3000,
+			},
+			want: map[string]interface{}{
+				TokenFieldTTL:    1200,
+				TokenFieldMaxTTL: 3000,
+			},
+		},
+		{
+			name: "legacy-fields",
+			data: map[string]interface{}{
+				"ttl":     1200,
+				"max_ttl": 3000,
+			},
+			want: map[string]interface{}{
+				TokenFieldTTL:    1200,
+				TokenFieldMaxTTL: 3000,
+			},
+		},
+		{
+			name: "no-fields",
+			data: map[string]interface{}{},
+			want: map[string]interface{}{
+				TokenFieldTTL:    nil,
+				TokenFieldMaxTTL: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := githubAuthBackendTokenFieldMap(&api.Secret{Data: tt.data})
+			for k, want := range tt.want {
+				if !reflect.DeepEqual(got[k], want) {
+					t.Errorf("githubAuthBackendTokenFieldMap() %s = %v, want %v", k, got[k], want)
+				}
+			}
+		})
+	}
+}
+
 func TestAccGithubAuthBackend_tuning(t *testing.T) {
 	testutil.SkipTestAcc(t)
 
@@ -271,6 +327,9 @@ resource "vault_github_auth_backend" "gh" {
 	organization_id = %d
 	token_ttl = 2400
 	token_max_ttl = 6000
+	token_num_uses = 10
+	token_policies = ["default", "dev"]
+	token_bound_cidrs = ["10.0.0.0/8"]
 }
 `, path, org, orgID)
 }
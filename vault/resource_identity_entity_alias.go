@@ -20,7 +20,7 @@ func identityEntityAliasResource() *schema.Resource {
 		ReadContext:   identityEntityAliasRead,
 		DeleteContext: identityEntityAliasDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: identityEntityAliasImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -262,6 +262,53 @@ func identityEntityAliasDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// identityEntityAliasImport supports importing by either the alias ID, or by
+// the composite "mount_accessor/name".
+func identityEntityAliasImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	mountAccessor, name := parts[0], parts[1]
+	if mountAccessor == "" || name == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected format <mount_accessor>/<name>", id)
+	}
+
+	aliasID, err := identityEntityAliasLookupID(meta.(*api.Client), name, mountAccessor)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Found entity alias %q for import ID %q", aliasID, id)
+	d.SetId(aliasID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// identityEntityAliasLookupID returns the ID of the single entity alias
+// matching name and mountAccessor.
+func identityEntityAliasLookupID(client *api.Client, name, mountAccessor string) (string, error) {
+	ids, err := entity.FindAliasIDs(client, &entity.FindAliasParams{
+		Name:          name,
+		MountAccessor: mountAccessor,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list entity aliases, err=%s", err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no entity alias %q found for mount accessor %q", name, mountAccessor)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("multiple entity aliases %q found for mount accessor %q, ids=%q",
+			name, mountAccessor, strings.Join(ids, ","))
+	}
+}
+
 func getEntityAliasLockFuncs(d *schema.ResourceData) (func(), func()) {
 	mountAccessor := d.Get("mount_accessor").(string)
 	lockKey := strings.Join([]string{entity.RootAliasIDPath, mountAccessor}, "/")
@@ -39,6 +39,18 @@ func TestAccIdentityEntityAlias(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName: nameEntityAlias,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[nameEntityAlias]
+					if !ok {
+						return "", fmt.Errorf("resource %q not found in state", nameEntityAlias)
+					}
+					return rs.Primary.Attributes["mount_accessor"] + "/" + rs.Primary.Attributes["name"], nil
+				},
+				ImportStateVerify: true,
+			},
 			{
 				Config:      testAccIdentityEntityAliasConfig(entity, true, false),
 				ExpectError: regexp.MustCompile(`entity alias .+ already exists`),
@@ -1,11 +1,15 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/group"
 )
 
 const identityGroupAliasPath = "/identity/group-alias"
@@ -18,7 +22,7 @@ func identityGroupAliasResource() *schema.Resource {
 		Delete: identityGroupAliasDelete,
 		Exists: identityGroupAliasExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: identityGroupAliasImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +84,9 @@ func identityGroupAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error updating IdentityGroupAlias %q: %s", id, err)
 	}
+	if resp == nil {
+		return fmt.Errorf("error updating IdentityGroupAlias %q: not found", id)
+	}
 
 	data := map[string]interface{}{
 		"name":           resp.Data["name"],
@@ -173,6 +180,52 @@ func identityGroupAliasExists(d *schema.ResourceData, meta interface{}) (bool, e
 	return resp != nil, nil
 }
 
+func identityGroupAliasImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	mountAccessor, canonicalID, name := parts[0], parts[1], parts[2]
+	if mountAccessor == "" || canonicalID == "" || name == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected format <mount_accessor>/<canonical_id>/<name>", id)
+	}
+
+	aliasID, err := identityGroupAliasLookupID(meta.(*api.Client), mountAccessor, canonicalID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Found group alias %q for import ID %q", aliasID, id)
+	d.SetId(aliasID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// identityGroupAliasLookupID returns the ID of the single group alias
+// matching mountAccessor, canonicalID and name.
+func identityGroupAliasLookupID(client *api.Client, mountAccessor, canonicalID, name string) (string, error) {
+	ids, err := group.FindAliasIDs(client, &group.FindAliasParams{
+		Name:          name,
+		MountAccessor: mountAccessor,
+		CanonicalID:   canonicalID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list group aliases, err=%s", err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no group alias %q found for mount accessor %q and group %q", name, mountAccessor, canonicalID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("multiple group aliases %q found for mount accessor %q and group %q, ids=%q",
+			name, mountAccessor, canonicalID, strings.Join(ids, ","))
+	}
+}
+
 func identityGroupAliasNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", identityGroupAliasPath, name)
 }
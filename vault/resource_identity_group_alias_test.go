@@ -32,6 +32,24 @@ func TestAccIdentityGroupAlias(t *testing.T) {
 					resource.TestCheckResourceAttrPair(nameGroupAlias, "mount_accessor", nameGithubA, "accessor"),
 				),
 			},
+			{
+				ResourceName:      nameGroupAlias,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: nameGroupAlias,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[nameGroupAlias]
+					if !ok {
+						return "", fmt.Errorf("resource %q not found in state", nameGroupAlias)
+					}
+					attrs := rs.Primary.Attributes
+					return attrs["mount_accessor"] + "/" + attrs["canonical_id"] + "/" + attrs["name"], nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
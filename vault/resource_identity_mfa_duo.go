@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMFAMethodTypeDuo = "duo"
+
+func identityMFADuoResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityMFADuoCreate,
+		Update: identityMFADuoUpdate,
+		Read:   identityMFADuoRead,
+		Delete: identityMFADuoDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"method_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the MFA method generated by Vault.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key for Duo.",
+			},
+			"integration_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Integration key for Duo.",
+			},
+			"api_hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API hostname for Duo.",
+			},
+			"push_info": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Push information for Duo.",
+			},
+		},
+	}
+}
+
+func identityMFADuoRequestData(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"secret_key":      d.Get("secret_key").(string),
+		"integration_key": d.Get("integration_key").(string),
+		"api_hostname":    d.Get("api_hostname").(string),
+		"push_info":       d.Get("push_info").(string),
+	}
+}
+
+func identityMFADuoCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(identityMFAMethodTypeDuo)
+
+	log.Printf("[DEBUG] Creating Identity MFA Duo method")
+	resp, err := client.Logical().Write(path, identityMFADuoRequestData(d))
+	if err != nil {
+		return fmt.Errorf("error creating Identity MFA Duo method: %s", err)
+	}
+
+	if resp == nil || resp.Data["method_id"] == nil {
+		return fmt.Errorf("no method_id returned when creating Identity MFA Duo method")
+	}
+
+	id := resp.Data["method_id"].(string)
+	log.Printf("[DEBUG] Created Identity MFA Duo method %q", id)
+	d.SetId(id)
+
+	return identityMFADuoRead(d, meta)
+}
+
+func identityMFADuoUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeDuo, id)
+
+	log.Printf("[DEBUG] Updating Identity MFA Duo method %q", id)
+	if _, err := client.Logical().Write(path, identityMFADuoRequestData(d)); err != nil {
+		return fmt.Errorf("error updating Identity MFA Duo method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated Identity MFA Duo method %q", id)
+
+	return identityMFADuoRead(d, meta)
+}
+
+func identityMFADuoRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeDuo, id)
+
+	log.Printf("[DEBUG] Reading Identity MFA Duo method %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Identity MFA Duo method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read Identity MFA Duo method %q", id)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity MFA Duo method %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("method_id", id); err != nil {
+		return err
+	}
+
+	if err := d.Set("api_hostname", resp.Data["api_hostname"]); err != nil {
+		return err
+	}
+
+	// Vault accepts push_info but responds with pushinfo.
+	if err := d.Set("push_info", resp.Data["pushinfo"]); err != nil {
+		return err
+	}
+
+	// secret_key and integration_key are never returned by Vault,
+	// so the configured values are kept as-is.
+
+	return nil
+}
+
+func identityMFADuoDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeDuo, id)
+
+	log.Printf("[DEBUG] Deleting Identity MFA Duo method %q", id)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Identity MFA Duo method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted Identity MFA Duo method %q", id)
+
+	return nil
+}
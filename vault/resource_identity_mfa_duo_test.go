@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestIdentityMFADuo(t *testing.T) {
+	resourceName := "vault_identity_mfa_duo.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testIdentityMFAMethodCheckDestroy(identityMFAMethodTypeDuo, "vault_identity_mfa_duo"),
+		Steps: []resource.TestStep{
+			{
+				Config: testIdentityMFADuoConfig("api-2b5c39f5.duosecurity.com", "from=loginportal&domain=example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "method_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key", "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"),
+					resource.TestCheckResourceAttr(resourceName, "integration_key", "BIACEUEAXI20BNWTEYXT"),
+					resource.TestCheckResourceAttr(resourceName, "api_hostname", "api-2b5c39f5.duosecurity.com"),
+					resource.TestCheckResourceAttr(resourceName, "push_info", "from=loginportal&domain=example.com"),
+				),
+			},
+			{
+				Config: testIdentityMFADuoConfig("api-3c6d40a6.duosecurity.com", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "api_hostname", "api-3c6d40a6.duosecurity.com"),
+					resource.TestCheckResourceAttr(resourceName, "push_info", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "integration_key"},
+			},
+		},
+	})
+}
+
+func testIdentityMFADuoConfig(apiHostname, pushInfo string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_duo" "test" {
+  secret_key      = "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"
+  integration_key = "BIACEUEAXI20BNWTEYXT"
+  api_hostname    = "%s"
+  push_info       = "%s"
+}
+`, apiHostname, pushInfo)
+}
@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMFALoginEnforcementBasePath = "identity/mfa/login-enforcement"
+
+// identityMFALoginEnforcementTargetFields are the fields used to select which
+// logins are subject to MFA; Vault requires at least one of them.
+var identityMFALoginEnforcementTargetFields = []string{
+	"auth_method_accessors",
+	"auth_method_types",
+	"identity_group_ids",
+	"identity_entity_ids",
+}
+
+func identityMFALoginEnforcementPath(name string) string {
+	return identityMFALoginEnforcementBasePath + "/" + name
+}
+
+func identityMFALoginEnforcementResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityMFALoginEnforcementWrite,
+		Update: identityMFALoginEnforcementWrite,
+		Read:   identityMFALoginEnforcementRead,
+		Delete: identityMFALoginEnforcementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the login enforcement.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"mfa_method_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of MFA method IDs to enforce.",
+			},
+			"auth_method_accessors": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Set of auth method accessors the enforcement applies to.",
+				AtLeastOneOf: identityMFALoginEnforcementTargetFields,
+			},
+			"auth_method_types": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Set of auth method types the enforcement applies to.",
+				AtLeastOneOf: identityMFALoginEnforcementTargetFields,
+			},
+			"identity_group_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Set of identity group IDs the enforcement applies to.",
+				AtLeastOneOf: identityMFALoginEnforcementTargetFields,
+			},
+			"identity_entity_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Set of identity entity IDs the enforcement applies to.",
+				AtLeastOneOf: identityMFALoginEnforcementTargetFields,
+			},
+		},
+	}
+}
+
+func identityMFALoginEnforcementWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityMFALoginEnforcementPath(name)
+
+	data := map[string]interface{}{
+		"mfa_method_ids": d.Get("mfa_method_ids").(*schema.Set).List(),
+	}
+	for _, k := range identityMFALoginEnforcementTargetFields {
+		data[k] = d.Get(k).(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing Identity MFA login enforcement %q", name)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Identity MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote Identity MFA login enforcement %q", name)
+
+	d.SetId(name)
+
+	return identityMFALoginEnforcementRead(d, meta)
+}
+
+func identityMFALoginEnforcementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	path := identityMFALoginEnforcementPath(name)
+
+	log.Printf("[DEBUG] Reading Identity MFA login enforcement %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Identity MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read Identity MFA login enforcement %q", name)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity MFA login enforcement %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+
+	fields := append([]string{"mfa_method_ids"}, identityMFALoginEnforcementTargetFields...)
+	for _, k := range fields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on Identity MFA login enforcement %q: %s", k, name, err)
+		}
+	}
+
+	return nil
+}
+
+func identityMFALoginEnforcementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	path := identityMFALoginEnforcementPath(name)
+
+	log.Printf("[DEBUG] Deleting Identity MFA login enforcement %q", name)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Identity MFA login enforcement %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted Identity MFA login enforcement %q", name)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestIdentityMFALoginEnforcement(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	mount := acctest.RandomWithPrefix("userpass")
+	resourceName := "vault_identity_mfa_login_enforcement.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testIdentityMFALoginEnforcementCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testIdentityMFALoginEnforcementConfig_noTargets(name),
+				ExpectError: regexp.MustCompile(`one of .+ must be specified`),
+			},
+			{
+				Config: testIdentityMFALoginEnforcementConfig(name, mount, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "mfa_method_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "0"),
+				),
+			},
+			{
+				Config: testIdentityMFALoginEnforcementConfig(name, mount, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auth_method_types.*", "userpass"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIdentityMFALoginEnforcementCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_mfa_login_enforcement" {
+			continue
+		}
+
+		resp, err := client.Logical().Read(identityMFALoginEnforcementPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+
+		if resp != nil {
+			return fmt.Errorf("Identity MFA login enforcement %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testIdentityMFALoginEnforcementConfig_noTargets(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_login_enforcement" "test" {
+  name           = "%s"
+  mfa_method_ids = ["00000000-0000-0000-0000-000000000000"]
+}
+`, name)
+}
+
+func testIdentityMFALoginEnforcementConfig(name, mount string, withTypes bool) string {
+	var types string
+	if withTypes {
+		types = `auth_method_types     = ["userpass"]`
+	}
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "%s"
+}
+
+resource "vault_identity_mfa_login_enforcement" "test" {
+  name                  = "%s"
+  mfa_method_ids        = [vault_identity_mfa_totp.test.method_id]
+  auth_method_accessors = [vault_auth_backend.userpass.accessor]
+  %s
+}
+`, mount, name, name, types)
+}
@@ -0,0 +1,179 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMFAMethodTypeTOTP = "totp"
+
+var identityMFATOTPFields = []string{
+	"issuer",
+	"period",
+	"key_size",
+	"algorithm",
+	"digits",
+	"skew",
+}
+
+func identityMFATOTPResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityMFATOTPCreate,
+		Update: identityMFATOTPUpdate,
+		Read:   identityMFATOTPRead,
+		Delete: identityMFATOTPDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"method_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the MFA method generated by Vault.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				Description:  "Specifies the size in bytes of the generated key.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "SHA1",
+				Description: "Specifies the hashing algorithm used to generate the TOTP code. " +
+					"Options include 'SHA1', 'SHA256' and 'SHA512'.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  6,
+				Description: "The number of digits in the generated TOTP token. " +
+					"This value can either be 6 or 8.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				Description: "The number of delay periods that are allowed when validating a TOTP token. " +
+					"This value can either be 0 or 1.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+		},
+	}
+}
+
+func identityMFATOTPRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, k := range identityMFATOTPFields {
+		data[k] = d.Get(k)
+	}
+
+	return data
+}
+
+func identityMFATOTPCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(identityMFAMethodTypeTOTP)
+
+	log.Printf("[DEBUG] Creating Identity MFA TOTP method")
+	resp, err := client.Logical().Write(path, identityMFATOTPRequestData(d))
+	if err != nil {
+		return fmt.Errorf("error creating Identity MFA TOTP method: %s", err)
+	}
+
+	if resp == nil || resp.Data["method_id"] == nil {
+		return fmt.Errorf("no method_id returned when creating Identity MFA TOTP method")
+	}
+
+	id := resp.Data["method_id"].(string)
+	log.Printf("[DEBUG] Created Identity MFA TOTP method %q", id)
+	d.SetId(id)
+
+	return identityMFATOTPRead(d, meta)
+}
+
+func identityMFATOTPUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeTOTP, id)
+
+	log.Printf("[DEBUG] Updating Identity MFA TOTP method %q", id)
+	if _, err := client.Logical().Write(path, identityMFATOTPRequestData(d)); err != nil {
+		return fmt.Errorf("error updating Identity MFA TOTP method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated Identity MFA TOTP method %q", id)
+
+	return identityMFATOTPRead(d, meta)
+}
+
+func identityMFATOTPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeTOTP, id)
+
+	log.Printf("[DEBUG] Reading Identity MFA TOTP method %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Identity MFA TOTP method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read Identity MFA TOTP method %q", id)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity MFA TOTP method %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("method_id", id); err != nil {
+		return err
+	}
+
+	for _, k := range identityMFATOTPFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on Identity MFA TOTP method %q: %s", k, id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func identityMFATOTPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	path := identityMFAMethodIDPath(identityMFAMethodTypeTOTP, id)
+
+	log.Printf("[DEBUG] Deleting Identity MFA TOTP method %q", id)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Identity MFA TOTP method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted Identity MFA TOTP method %q", id)
+
+	return nil
+}
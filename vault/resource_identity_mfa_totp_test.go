@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestIdentityMFATOTP(t *testing.T) {
+	issuer := acctest.RandomWithPrefix("tf-test")
+	resourceName := "vault_identity_mfa_totp.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testIdentityMFAMethodCheckDestroy(identityMFAMethodTypeTOTP, "vault_identity_mfa_totp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testIdentityMFATOTPConfig(issuer, 30, "SHA1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "method_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "6"),
+					resource.TestCheckResourceAttr(resourceName, "skew", "1"),
+				),
+			},
+			{
+				Config: testIdentityMFATOTPConfig(issuer, 60, "SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIdentityMFAMethodCheckDestroy(methodType, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			resp, err := client.Logical().Read(identityMFAMethodIDPath(methodType, rs.Primary.ID))
+			if err != nil {
+				return err
+			}
+
+			if resp != nil {
+				return fmt.Errorf("Identity MFA %s method %q still exists", methodType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testIdentityMFATOTPConfig(issuer string, period int, algorithm string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer    = "%s"
+  period    = %d
+  algorithm = "%s"
+}
+`, issuer, period, algorithm)
+}
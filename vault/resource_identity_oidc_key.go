@@ -1,10 +1,13 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
@@ -31,6 +34,11 @@ func identityOidcKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		// Changing rotate rotates the key on update, which sets a new last_rotated.
+		CustomizeDiff: customdiff.ComputedIf("last_rotated", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.Id() != "" && d.HasChange("rotate")
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -67,6 +75,18 @@ func identityOidcKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+
+			"rotate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, rotates the signing key using the configured verification_ttl.",
+			},
+
+			"last_rotated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the signing key was last created or rotated by Terraform, in RFC3339 format.",
+			},
 		},
 	}
 }
@@ -98,6 +118,10 @@ func identityOidcKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(name)
+	// A new key counts as rotated only when rotation is managed through rotate.
+	if _, ok := d.GetOk("rotate"); ok {
+		d.Set("last_rotated", time.Now().UTC().Format(time.RFC3339))
+	}
 
 	return identityOidcKeyRead(d, meta)
 }
@@ -119,6 +143,13 @@ func identityOidcKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("rotate") {
+		if err := identityOidcKeyApiRotate(name, d.Get("verification_ttl").(int), client); err != nil {
+			return err
+		}
+		d.Set("last_rotated", time.Now().UTC().Format(time.RFC3339))
+	}
+
 	return identityOidcKeyRead(d, meta)
 }
 
@@ -220,3 +251,18 @@ func identityOidcKeyApiWrite(name string, data map[string]interface{}, client *a
 
 	return nil
 }
+
+func identityOidcKeyApiRotate(name string, verificationTTL int, client *api.Client) error {
+	path := identityOidcKeyPath(name) + "/rotate"
+
+	log.Printf("[DEBUG] Rotating IdentityOidcKey %s at %s", name, path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"verification_ttl": verificationTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("error rotating IdentityOidcKey %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Rotated IdentityOidcKey %q", name)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -92,6 +93,46 @@ func TestAccIdentityOidcKeyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcKeyRotate(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+
+	var lastRotated string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcKeyConfigRotate(key, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate", "1"),
+					resource.TestCheckResourceAttrWith("vault_identity_oidc_key.key", "last_rotated", func(v string) error {
+						if v == "" {
+							return fmt.Errorf("expected last_rotated to be set")
+						}
+						lastRotated = v
+						return nil
+					}),
+				),
+			},
+			{
+				// ensure the rotation lands on a later second
+				PreConfig: func() { time.Sleep(time.Second) },
+				Config:    testAccIdentityOidcKeyConfigRotate(key, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate", "2"),
+					resource.TestCheckResourceAttrWith("vault_identity_oidc_key.key", "last_rotated", func(v string) error {
+						if v == lastRotated {
+							return fmt.Errorf("expected last_rotated to change after rotation, still %q", v)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityOidcKeyDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -237,3 +278,12 @@ resource "vault_identity_oidc_key" "key" {
 	allowed_client_ids = ["*"]
 }`, entityName)
 }
+
+func testAccIdentityOidcKeyConfigRotate(entityName, rotate string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name             = "%s"
+  verification_ttl = 3600
+  rotate           = "%s"
+}`, entityName, rotate)
+}
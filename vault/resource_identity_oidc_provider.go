@@ -3,6 +3,8 @@ package vault
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -16,6 +18,9 @@ func identityOIDCProviderResource() *schema.Resource {
 		Update: identityOIDCProviderCreateUpdate,
 		Read:   identityOIDCProviderRead,
 		Delete: identityOIDCProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityOIDCProviderImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -71,11 +76,13 @@ func identityOIDCProviderConfigData(d *schema.ResourceData) map[string]interface
 	}
 
 	for _, k := range nonBooleanFields {
+		if k == "allowed_client_ids" || k == "scopes_supported" {
+			// always send the sets, so that removing all elements is
+			// honoured on update.
+			configData[k] = d.Get(k).(*schema.Set).List()
+			continue
+		}
 		if v, ok := d.GetOk(k); ok {
-			if k == "allowed_client_ids" || k == "scopes_supported" {
-				configData[k] = v.(*schema.Set).List()
-				continue
-			}
 			configData[k] = v
 		}
 	}
@@ -148,9 +155,41 @@ func identityOIDCProviderRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := d.Set("name", strings.TrimPrefix(path, identityOIDCProviderPathPrefix+"/")); err != nil {
+		return fmt.Errorf("error setting state key \"name\" on OIDC Provider %q, err=%w", path, err)
+	}
+
+	// only derive issuer_host and https_enabled from the issuer when issuer_host
+	// is tracked in the config, since Vault defaults the issuer to its own api_addr.
+	if issuer, ok := resp.Data["issuer"].(string); ok && issuer != "" {
+		if _, ok := d.GetOk("issuer_host"); ok {
+			u, err := url.Parse(issuer)
+			if err != nil {
+				return fmt.Errorf("error parsing issuer %q on OIDC Provider %q, err=%w", issuer, path, err)
+			}
+			if err := d.Set("issuer_host", u.Host); err != nil {
+				return fmt.Errorf("error setting state key \"issuer_host\" on OIDC Provider %q, err=%w", path, err)
+			}
+			if err := d.Set("https_enabled", u.Scheme == "https"); err != nil {
+				return fmt.Errorf("error setting state key \"https_enabled\" on OIDC Provider %q, err=%w", path, err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// identityOIDCProviderImport accepts either the provider name or its full
+// path as the import ID.
+func identityOIDCProviderImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if !strings.HasPrefix(id, identityOIDCProviderPathPrefix+"/") {
+		d.SetId(getOIDCProviderPath(id))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func identityOIDCProviderDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
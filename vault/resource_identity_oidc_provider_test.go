@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"net/url"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -20,6 +22,17 @@ func TestAccIdentityOIDCProvider(t *testing.T) {
 	scopeName := acctest.RandomWithPrefix("test-scope")
 
 	resourceName := "vault_identity_oidc_provider.test"
+	vaultAddrEnv := os.Getenv("VAULT_ADDR")
+	parsedUrl, err := url.Parse(vaultAddrEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host := parsedUrl.Host
+	if host == "localhost:8200" {
+		host = "127.0.0.1:8200"
+	}
+	httpsEnabled := parsedUrl.Scheme == "https"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testutil.TestAccPreCheck(t) },
@@ -27,19 +40,42 @@ func TestAccIdentityOIDCProvider(t *testing.T) {
 		CheckDestroy: testAccCheckOIDCProviderDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccIdentityOIDCProviderConfig(keyName, assignmentName, clientName, scopeName, providerName),
+				Config: testAccIdentityOIDCProviderConfig(keyName, assignmentName, clientName, scopeName, providerName, host, httpsEnabled),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", providerName),
 					resource.TestCheckResourceAttr(resourceName, "allowed_client_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scopes_supported.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scopes_supported.0", scopeName),
+					resource.TestCheckResourceAttr(resourceName, "issuer", fmt.Sprintf("%s://%s", parsedUrl.Scheme, host)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_host", "https_enabled"},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           providerName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_host", "https_enabled"},
+			},
+			{
+				Config: testAccIdentityOIDCProviderConfigAllClients(providerName, host, httpsEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", providerName),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_ids.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "scopes_supported.#", "0"),
 				),
 			},
 		},
 	})
 }
 
-func testAccIdentityOIDCProviderConfig(keyName, assignmentName, clientName, scopeName, providerName string) string {
+func testAccIdentityOIDCProviderConfig(keyName, assignmentName, clientName, scopeName, providerName, issuerHost string, httpsEnabled bool) string {
 	return fmt.Sprintf(`
 resource "vault_identity_oidc_key" "test" {
   name               = "%s"
@@ -81,15 +117,25 @@ resource "vault_identity_oidc_scope" "test" {
 
 resource "vault_identity_oidc_provider" "test" {
   name = "%s"
-  https_enabled = false
-  issuer_host = "127.0.0.1:8200"
+  https_enabled = %t
+  issuer_host = "%s"
   allowed_client_ids = [
      vault_identity_oidc_client.test.client_id
   ]
   scopes_supported = [
     vault_identity_oidc_scope.test.name
   ]
-}`, keyName, assignmentName, clientName, scopeName, providerName)
+}`, keyName, assignmentName, clientName, scopeName, providerName, httpsEnabled, issuerHost)
+}
+
+func testAccIdentityOIDCProviderConfigAllClients(providerName, issuerHost string, httpsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_provider" "test" {
+  name               = "%s"
+  https_enabled      = %t
+  issuer_host        = "%s"
+  allowed_client_ids = ["*"]
+}`, providerName, httpsEnabled, issuerHost)
 }
 
 func testAccCheckOIDCProviderDestroy(s *terraform.State) error {
@@ -1,12 +1,15 @@
 package vault
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -117,6 +120,13 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Default:     false,
 			Description: "Log received OIDC tokens and claims when debug-level logging is active. Not recommended in production since sensitive information may be present in OIDC responses.",
 		},
+		"callback_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The OIDC callback mode to use: \"client\", \"direct\" or \"device\". Only applies to OIDC roles.",
+			ValidateFunc: validation.StringInSlice([]string{"client", "direct", "device"}, false),
+		},
 		"backend": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -141,11 +151,40 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: jwtAuthBackendRoleCustomizeDiff,
 
 		Schema: fields,
 	}
 }
 
+// jwtAuthBackendRoleCustomizeDiff mirrors Vault's requirement that roles of
+// type "jwt" set at least one of bound_audiences, bound_subject or
+// bound_claims.
+func jwtAuthBackendRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("role_type") || d.Get("role_type").(string) != "jwt" {
+		return nil
+	}
+
+	attributes := []string{
+		"bound_audiences",
+		"bound_subject",
+		"bound_claims",
+		"token_bound_cidrs",
+	}
+
+	for _, attr := range attributes {
+		if !d.NewValueKnown(attr) {
+			return nil
+		}
+
+		if _, ok := d.GetOk(attr); ok {
+			return nil
+		}
+	}
+
+	return errors.New("bound_audiences is required for roles of type \"jwt\" when none of bound_subject, bound_claims or token_bound_cidrs are set")
+}
+
 func jwtAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -275,6 +314,9 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := resp.Data["verbose_oidc_logging"]; ok {
 		d.Set("verbose_oidc_logging", v)
 	}
+	if v, ok := resp.Data["callback_mode"]; ok {
+		d.Set("callback_mode", v)
+	}
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
@@ -434,5 +476,9 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 
 	data["verbose_oidc_logging"] = d.Get("verbose_oidc_logging").(bool)
 
+	if v, ok := d.GetOk("callback_mode"); ok {
+		data["callback_mode"] = v.(string)
+	}
+
 	return data
 }
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -123,6 +124,29 @@ func TestAccJWTAuthBackendRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccJWTAuthBackendRole_boundAudiencesRequired(t *testing.T) {
+	backend := acctest.RandomWithPrefix("jwt")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckJWTAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJWTAuthBackendRoleConfig_noBounds(backend, role),
+				ExpectError: regexp.MustCompile(`bound_audiences is required for roles of type "jwt"`),
+			},
+			{
+				// token_bound_cidrs alone is a valid bound constraint
+				Config:             testAccJWTAuthBackendRoleConfig_boundCIDRsOnly(backend, role),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccJWTAuthBackendRole_update(t *testing.T) {
 	backend := acctest.RandomWithPrefix("jwt")
 	role := acctest.RandomWithPrefix("test-role")
@@ -330,6 +354,37 @@ func TestAccJWTAuthBackendRoleOIDC_full(t *testing.T) {
 	})
 }
 
+func TestAccJWTAuthBackendRoleOIDC_callbackMode(t *testing.T) {
+	backend := acctest.RandomWithPrefix("oidc")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckJWTAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJWTAuthBackendRoleConfigOIDC_callbackMode(backend, role, "invalid"),
+				ExpectError: regexp.MustCompile(`expected callback_mode to be one of \[client direct device\]`),
+			},
+			{
+				Config: testAccJWTAuthBackendRoleConfigOIDC_callbackMode(backend, role, "device"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"callback_mode", "device"),
+				),
+			},
+			{
+				Config: testAccJWTAuthBackendRoleConfigOIDC_callbackMode(backend, role, "direct"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"callback_mode", "direct"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccJWTAuthBackendRoleOIDC_disableParsing(t *testing.T) {
 	backend := acctest.RandomWithPrefix("jwt")
 	role := acctest.RandomWithPrefix("test-role")
@@ -516,6 +571,39 @@ resource "vault_jwt_auth_backend_role" "role" {
 }`, backend, role)
 }
 
+func testAccJWTAuthBackendRoleConfig_noBounds(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "jwt" {
+  type = "jwt"
+  path = "%s"
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend = vault_auth_backend.jwt.path
+  role_name = "%s"
+  role_type = "jwt"
+
+  user_claim = "https://vault/user"
+}`, backend, role)
+}
+
+func testAccJWTAuthBackendRoleConfig_boundCIDRsOnly(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "jwt" {
+  type = "jwt"
+  path = "%s"
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend = vault_auth_backend.jwt.path
+  role_name = "%s"
+  role_type = "jwt"
+
+  user_claim        = "https://vault/user"
+  token_bound_cidrs = ["10.0.0.0/8"]
+}`, backend, role)
+}
+
 func testAccJWTAuthBackendRoleConfig_update(backend, role string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "jwt" {
@@ -608,6 +696,32 @@ resource "vault_jwt_auth_backend_role" "role" {
 }`, backend, role)
 }
 
+func testAccJWTAuthBackendRoleConfigOIDC_callbackMode(backend, role, callbackMode string) string {
+	return fmt.Sprintf(`
+resource "vault_jwt_auth_backend" "jwt" {
+  type = "oidc"
+  path = "%s"
+  oidc_discovery_url = "https://myco.auth0.com/"
+  oidc_client_id = "client"
+  oidc_client_secret = "secret"
+  lifecycle {
+  ignore_changes = [
+     # Ignore changes to oidc_client_secret inside the tests
+     "oidc_client_secret"
+    ]
+  }
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend = vault_jwt_auth_backend.jwt.path
+  role_name = "%s"
+  role_type = "oidc"
+  allowed_redirect_uris = ["http://localhost:8080"]
+  user_claim = "https://vault/user"
+  callback_mode = "%s"
+}`, backend, role, callbackMode)
+}
+
 func testAccJWTAuthBackendRoleConfigOIDC_disableParsing(backend, role string) string {
 	return fmt.Sprintf(`
 resource "vault_jwt_auth_backend" "jwt" {
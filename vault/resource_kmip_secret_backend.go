@@ -137,9 +137,8 @@ func kmipSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Remount %s to %s in Vault", path, newPath)
 
-		err := client.Sys().Remount(path, newPath)
-		if err != nil {
-			return fmt.Errorf("error remounting in Vault: %s", err)
+		if err := remountAndWait(client, path, newPath); err != nil {
+			return err
 		}
 
 		d.SetId(newPath)
@@ -229,7 +229,7 @@ func kubernetesAuthBackendConfigUpdate(d *schema.ResourceData, meta interface{})
 		data["disable_iss_validation"] = v
 	}
 
-	if v, ok := d.GetOk("disable_local_ca_jwt"); ok {
+	if v, ok := d.GetOkExists("disable_local_ca_jwt"); ok {
 		data["disable_local_ca_jwt"] = v
 	}
 
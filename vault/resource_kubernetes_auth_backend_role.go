@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -55,10 +56,11 @@ func kubernetesAuthBackendRoleResource() *schema.Resource {
 			Description: "Optional Audience claim to verify in the JWT.",
 		},
 		"alias_name_source": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "Configures how identity aliases are generated. Valid choices are: serviceaccount_uid, serviceaccount_name",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Configures how identity aliases are generated. Valid choices are: serviceaccount_uid, serviceaccount_name",
+			ValidateFunc: validation.StringInSlice([]string{"serviceaccount_uid", "serviceaccount_name"}, false),
 		},
 	}
 
@@ -0,0 +1,227 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kubernetesSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
+	kubernetesSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+
+	// kubernetesSecretBackendRoleFields are the request params that map
+	// directly to the schema fields of the same name.
+	kubernetesSecretBackendRoleFields = []string{
+		"allowed_kubernetes_namespaces",
+		"token_max_ttl",
+		"token_default_ttl",
+		"service_account_name",
+		"kubernetes_role_name",
+		"kubernetes_role_type",
+		"generated_role_rules",
+		"name_template",
+	}
+
+	kubernetesSecretBackendRoleCredFields = []string{
+		"service_account_name",
+		"kubernetes_role_name",
+		"generated_role_rules",
+	}
+)
+
+func kubernetesSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendRoleWrite,
+		Read:   kubernetesSecretBackendRoleRead,
+		Update: kubernetesSecretBackendRoleWrite,
+		Delete: kubernetesSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Kubernetes Secrets Engine backend mount to create the role in.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"allowed_kubernetes_namespaces": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of Kubernetes namespaces this role can generate credentials for. If set to '*' all namespaces are allowed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"token_max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum TTL for generated Kubernetes tokens in seconds.",
+			},
+			"token_default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The default TTL for generated Kubernetes tokens in seconds.",
+			},
+			"service_account_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: kubernetesSecretBackendRoleCredFields,
+				Description:  "The pre-existing service account to generate tokens for.",
+			},
+			"kubernetes_role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: kubernetesSecretBackendRoleCredFields,
+				Description:  "The pre-existing Role or ClusterRole to bind a generated service account to.",
+			},
+			"kubernetes_role_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Role",
+				Description:  "Specifies whether the Kubernetes role is a Role or ClusterRole.",
+				ValidateFunc: validation.StringInSlice([]string{"Role", "ClusterRole"}, false),
+			},
+			"generated_role_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: kubernetesSecretBackendRoleCredFields,
+				Description:  "The Role or ClusterRole rules, in JSON or YAML format, to use when generating a role.",
+			},
+			"name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name template to use when generating service accounts, roles and role bindings.",
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := kubernetesSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{}
+	for _, k := range kubernetesSecretBackendRoleFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing Kubernetes secret backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kubernetes secret backend role %q", path)
+
+	d.SetId(path)
+
+	return kubernetesSecretBackendRoleRead(d, meta)
+}
+
+func kubernetesSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	name, err := kubernetesSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Kubernetes secret backend role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	backend, err := kubernetesSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		log.Printf("[WARN] Removing Kubernetes secret backend role %q because its ID is invalid", path)
+		d.SetId("")
+		return fmt.Errorf("invalid role ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Kubernetes secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kubernetes secret backend role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Kubernetes secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("backend", backend); err != nil {
+		return err
+	}
+
+	for _, k := range kubernetesSecretBackendRoleFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q for Kubernetes secret backend role %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Kubernetes secret backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Kubernetes secret backend role %q", path)
+
+	return nil
+}
+
+func kubernetesSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + name
+}
+
+func kubernetesSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := kubernetesSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
+
+func kubernetesSecretBackendRoleBackendFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kubernetesSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestKubernetesSecretBackendRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resourceName := "vault_kubernetes_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKubernetesSecretBackendRole_serviceAccountConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "token_default_ttl", "43200"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", "test-service-account"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_type", "Role"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKubernetesSecretBackendRole_generatedRulesConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.0", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.1", "int"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_type", "ClusterRole"),
+					resource.TestCheckResourceAttr(resourceName, "name_template", "vault-{{.RoleName}}"),
+					resource.TestCheckResourceAttrSet(resourceName, "generated_role_rules"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKubernetesSecretBackendRole_serviceAccountConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kubernetes"
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = vault_mount.test.path
+  name                          = "%s"
+  allowed_kubernetes_namespaces = ["*"]
+  token_max_ttl                 = 86400
+  token_default_ttl             = 43200
+  service_account_name          = "test-service-account"
+}
+`, backend, name)
+}
+
+func testKubernetesSecretBackendRole_generatedRulesConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kubernetes"
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = vault_mount.test.path
+  name                          = "%s"
+  allowed_kubernetes_namespaces = ["dev", "int"]
+  token_max_ttl                 = 86400
+  token_default_ttl             = 43200
+  kubernetes_role_type          = "ClusterRole"
+  name_template                 = "vault-{{.RoleName}}"
+  generated_role_rules          = <<EOT
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["list"]
+EOT
+}
+`, backend, name)
+}
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func kvSecretBackendV2Resource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretBackendV2Write,
+		Update: kvSecretBackendV2Write,
+		Read:   kvSecretBackendV2Read,
+		Delete: kvSecretBackendV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"max_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of versions to keep per key. A value of 0 keeps the Vault default of 10 versions.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cas_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, all keys will require the cas parameter to be set on all write requests.",
+			},
+			"delete_version_after": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "If set, specifies the length of time in seconds before a version is deleted. " +
+					"A value of 0 disables the automatic deletion of versions.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func kvSecretBackendV2Write(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	path := kvSecretBackendV2ConfigPath(mount)
+
+	data := map[string]interface{}{
+		"max_versions":         d.Get("max_versions").(int),
+		"cas_required":         d.Get("cas_required").(bool),
+		"delete_version_after": fmt.Sprintf("%ds", d.Get("delete_version_after").(int)),
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV-V2 backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 backend config %q", path)
+
+	d.SetId(path)
+
+	return kvSecretBackendV2Read(d, meta)
+}
+
+func kvSecretBackendV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if !strings.HasSuffix(path, "/config") {
+		return fmt.Errorf("invalid KV-V2 backend config ID %q, expected <mount>/config", path)
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 backend config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 backend config %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] KV-V2 backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("mount", strings.TrimSuffix(path, "/config")); err != nil {
+		return err
+	}
+
+	if v, ok := resp.Data["max_versions"].(json.Number); ok {
+		maxVersions, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected max_versions %q to be a number, and it isn't", v)
+		}
+		if err := d.Set("max_versions", maxVersions); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("cas_required", resp.Data["cas_required"]); err != nil {
+		return err
+	}
+
+	// Vault returns delete_version_after as a duration string, e.g. "1h0m0s".
+	if v, ok := resp.Data["delete_version_after"].(string); ok {
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("expected delete_version_after %q to be a duration, and it isn't", v)
+		}
+		if err := d.Set("delete_version_after", int(dur.Seconds())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func kvSecretBackendV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	// Nothing to reset when the mount is already gone, e.g. when it is
+	// destroyed in the same apply.
+	mount := strings.TrimSuffix(path, "/config")
+	enabled, err := util.CheckMountEnabled(client, mount)
+	if err != nil {
+		return fmt.Errorf("error checking if mount %q exists: %s", mount, err)
+	}
+	if !enabled {
+		log.Printf("[WARN] Mount %q not found, skipping reset of KV-V2 backend config %q", mount, path)
+		return nil
+	}
+
+	// The config has no delete endpoint, restore Vault's defaults instead.
+	data := map[string]interface{}{
+		"max_versions":         0,
+		"cas_required":         false,
+		"delete_version_after": "0s",
+	}
+
+	log.Printf("[DEBUG] Resetting KV-V2 backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] KV-V2 backend config %q not found, nothing to reset", path)
+			return nil
+		}
+		return fmt.Errorf("error resetting KV-V2 backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset KV-V2 backend config %q", path)
+
+	return nil
+}
+
+func kvSecretBackendV2ConfigPath(mount string) string {
+	return mount + "/config"
+}
@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretBackendV2(t *testing.T) {
+	resourceName := "vault_kv_secret_backend_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretBackendV2Config(mount, 5, false, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount", mount),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "5"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "3600"),
+				),
+			},
+			{
+				Config: testKVSecretBackendV2Config(mount, 20, true, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "20"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKVSecretBackendV2Config_mountOnly(mount),
+				Check:  testKVSecretBackendV2CheckDefaults(mount),
+			},
+		},
+	})
+}
+
+func TestKVSecretBackendV2Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		mounted     bool
+		writeStatus int
+		wantWrite   bool
+		wantErr     bool
+	}{
+		{
+			name:      "reset",
+			mounted:   true,
+			wantWrite: true,
+		},
+		{
+			name:      "mount-removed",
+			mounted:   false,
+			wantWrite: false,
+		},
+		{
+			name:        "config-not-found",
+			mounted:     true,
+			writeStatus: http.StatusNotFound,
+			wantWrite:   true,
+		},
+		{
+			name:        "write-error",
+			mounted:     true,
+			writeStatus: http.StatusBadRequest,
+			wantWrite:   true,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var wrote bool
+			handler := func(w http.ResponseWriter, req *http.Request) {
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/sys/mounts":
+					mounts := map[string]interface{}{
+						"secret/": map[string]interface{}{"type": "kv"},
+					}
+					if tt.mounted {
+						mounts["kvv2/"] = map[string]interface{}{"type": "kv"}
+					}
+					m, err := json.Marshal(&api.Secret{Data: mounts})
+					if err != nil {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					w.Write(m)
+				case req.Method == http.MethodPut && req.URL.Path == "/v1/kvv2/config":
+					wrote = true
+					if tt.writeStatus != 0 {
+						w.WriteHeader(tt.writeStatus)
+						return
+					}
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}
+
+			config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(handler))
+			defer ln.Close()
+
+			config.Address = fmt.Sprintf("http://%s", ln.Addr())
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := kvSecretBackendV2Resource().TestResourceData()
+			d.SetId(kvSecretBackendV2ConfigPath("kvv2"))
+
+			err = kvSecretBackendV2Delete(d, client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("kvSecretBackendV2Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if wrote != tt.wantWrite {
+				t.Errorf("expected config write %t, actual %t", tt.wantWrite, wrote)
+			}
+		})
+	}
+}
+
+func testKVSecretBackendV2CheckDefaults(mount string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		path := kvSecretBackendV2ConfigPath(mount)
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading KV-V2 backend config %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("KV-V2 backend config %q not found", path)
+		}
+
+		expected := map[string]interface{}{
+			"max_versions":         json.Number("0"),
+			"cas_required":         false,
+			"delete_version_after": "0s",
+		}
+		for k, v := range expected {
+			if resp.Data[k] != v {
+				return fmt.Errorf("expected %s of %q to be reset to %v, got %v", k, path, v, resp.Data[k])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretBackendV2Config_mountOnly(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+`, mount)
+}
+
+func testKVSecretBackendV2Config(mount string, maxVersions int, casRequired bool, deleteVersionAfter int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_backend_v2" "test" {
+  mount                = vault_mount.kvv2.path
+  max_versions         = %d
+  cas_required         = %t
+  delete_version_after = %d
+}
+`, mount, maxVersions, casRequired, deleteVersionAfter)
+}
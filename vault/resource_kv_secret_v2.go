@@ -0,0 +1,299 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var kvV2SecretFromPathRegex = regexp.MustCompile("^(.+?)/data/(.+)$")
+
+func kvSecretV2Resource(name string) *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretV2Write,
+		Update: kvSecretV2Update,
+		Delete: kvSecretV2Delete,
+		Read:   kvSecretV2Read,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret will be written.",
+			},
+			"data_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "JSON-encoded secret data to write.",
+				StateFunc:    NormalizeDataJSONFunc(name),
+				ValidateFunc: ValidateDataJSONFunc(name),
+				Sensitive:    true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+			"custom_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "A map of arbitrary string to string valued user-provided metadata. " +
+					"Updates to the custom metadata do not create a new version of the secret.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Metadata associated with the current version of the secret.",
+			},
+			"delete_all_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, permanently deletes all versions for the specified key.",
+			},
+		},
+	}
+}
+
+func kvSecretV2Write(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+	path := getKVV2Path(mount, name, "data")
+
+	if err := kvSecretV2WriteData(d, client, path); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("custom_metadata"); ok {
+		if err := kvSecretV2WriteMetadata(d, client, getKVV2Path(mount, name, "metadata")); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(path)
+
+	return kvSecretV2Read(d, meta)
+}
+
+func kvSecretV2Update(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+
+	// Only write to the data endpoint when the secret data has changed,
+	// otherwise a metadata only change would create a new secret version.
+	if d.HasChange("data_json") {
+		if err := kvSecretV2WriteData(d, client, path); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("custom_metadata") {
+		if err := kvSecretV2WriteMetadata(d, client, getKVV2Path(mount, name, "metadata")); err != nil {
+			return err
+		}
+	}
+
+	return kvSecretV2Read(d, meta)
+}
+
+func kvSecretV2WriteData(d *schema.ResourceData, client *api.Client, path string) error {
+	var secretData map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &secretData); err != nil {
+		return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+	}
+
+	data := map[string]interface{}{
+		"data":    secretData,
+		"options": map[string]interface{}{},
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 secret data to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing secret data to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 secret data to %q", path)
+
+	return nil
+}
+
+func kvSecretV2WriteMetadata(d *schema.ResourceData, client *api.Client, path string) error {
+	data := map[string]interface{}{
+		"custom_metadata": d.Get("custom_metadata"),
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 secret metadata to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing secret metadata to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 secret metadata to %q", path)
+
+	return nil
+}
+
+func kvSecretV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	mount := d.Get("mount").(string)
+	if mount == "" {
+		// on import the mount is unknown, ask Vault for it since the
+		// mount path itself may contain "/data/"
+		mountPath, _, err := isKVv2(path, client)
+		if err != nil {
+			return fmt.Errorf("error determining the mount of %q: %s", path, err)
+		}
+		mount = mountPath
+	}
+
+	mount, name, err := kvSecretV2MountAndNameFromPath(path, mount)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret %q from Vault", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+
+	if secret == nil || secret.Data["data"] == nil {
+		log.Printf("[WARN] KV-V2 secret %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for k, v := range map[string]string{
+		"mount": mount,
+		"name":  name,
+		"path":  path,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	secretData, _ := secret.Data["data"].(map[string]interface{})
+	jsonData, err := json.Marshal(secretData)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+	if err := d.Set("data_json", string(jsonData)); err != nil {
+		return err
+	}
+
+	dataMap := map[string]string{}
+	for k, v := range secretData {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	if err := d.Set("data", dataMap); err != nil {
+		return err
+	}
+
+	metadata := map[string]string{}
+	if v, ok := secret.Data["metadata"].(map[string]interface{}); ok {
+		for k, val := range v {
+			// custom_metadata is tracked separately below
+			if k == "custom_metadata" || val == nil {
+				continue
+			}
+			metadata[k] = fmt.Sprintf("%v", val)
+		}
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return err
+	}
+
+	metadataPath := getKVV2Path(mount, name, "metadata")
+	log.Printf("[DEBUG] Reading KV-V2 secret metadata %q from Vault", metadataPath)
+	resp, err := client.Logical().Read(metadataPath)
+	if err != nil {
+		return fmt.Errorf("error reading metadata from Vault: %s", err)
+	}
+
+	customMetadata := map[string]interface{}{}
+	if resp != nil {
+		if v, ok := resp.Data["custom_metadata"].(map[string]interface{}); ok {
+			customMetadata = v
+		}
+	}
+	if err := d.Set("custom_metadata", customMetadata); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func kvSecretV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	if d.Get("delete_all_versions").(bool) {
+		path = getKVV2Path(d.Get("mount").(string), d.Get("name").(string), "metadata")
+	}
+
+	log.Printf("[DEBUG] Deleting KV-V2 secret %q from Vault", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting %q from Vault: %q", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KV-V2 secret %q from Vault", path)
+
+	return nil
+}
+
+// kvSecretV2MountAndNameFromPath splits path into its mount and secret name.
+// When mount is known, the name is everything after "<mount>/data/",
+// otherwise the path is split on the first "/data/".
+func kvSecretV2MountAndNameFromPath(path, mount string) (string, string, error) {
+	mount = strings.Trim(mount, "/")
+	if mount != "" {
+		prefix := mount + "/data/"
+		if !strings.HasPrefix(path, prefix) || len(path) == len(prefix) {
+			return "", "", fmt.Errorf("invalid KV-V2 secret path %q, expected format %s<name>", path, prefix)
+		}
+		return mount, strings.TrimPrefix(path, prefix), nil
+	}
+
+	res := kvV2SecretFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("invalid KV-V2 secret path %q, expected format <mount>/data/<name>", path)
+	}
+
+	return res[1], res[2], nil
+}
@@ -0,0 +1,248 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretV2(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccKVSecretV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config(mount, name, "zap", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount", mount),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "path", fmt.Sprintf("%s/data/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "1"),
+				),
+			},
+			{
+				// custom_metadata only changes must not create a new secret version
+				Config: testKVSecretV2Config(mount, name, "zap", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "1"),
+				),
+			},
+			{
+				Config: testKVSecretV2Config(mount, name, "zoop", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zoop"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.foo", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_versions"},
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_nestedMount(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2") + "/data/nested"
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccKVSecretV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config(mount, name, "zap", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount", mount),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "path", fmt.Sprintf("%s/data/%s", mount, name)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_versions"},
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_deleteAllVersions(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_deleteAllVersions(mount, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_all_versions", "true"),
+				),
+			},
+			{
+				// keep the mount around so that the metadata can be checked
+				// once the secret has been destroyed
+				Config: testKVSecretV2Config_deleteAllVersions(mount, name, false),
+				Check:  testAccKVSecretV2CheckMetadataDeleted(mount, name),
+			},
+		},
+	})
+}
+
+func testAccKVSecretV2CheckMetadataDeleted(mount, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := getKVV2Path(mount, name, "metadata")
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("secret metadata %q still exists", path)
+		}
+		return nil
+	}
+}
+
+func testAccKVSecretV2CheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kv_secret_v2" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil && secret.Data["data"] != nil {
+			return fmt.Errorf("secret %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKVSecretV2Config(mount, name, value, customMetadata string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path        = "%s"
+  type        = "kv"
+  options     = { version = "2" }
+  description = "KV Version 2 secret engine mount"
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode({
+    zip = "%s"
+  })
+  custom_metadata = {
+    foo = "%s"
+  }
+}
+`, mount, name, value, customMetadata)
+}
+
+func testKVSecretV2Config_deleteAllVersions(mount, name string, withSecret bool) string {
+	config := fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path        = "%s"
+  type        = "kv"
+  options     = { version = "2" }
+  description = "KV Version 2 secret engine mount"
+}
+`, mount)
+
+	if withSecret {
+		config += fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  delete_all_versions = true
+  data_json = jsonencode({
+    zip = "zap"
+  })
+}
+`, name)
+	}
+
+	return config
+}
+
+func TestKVSecretV2MountAndNameFromPath(t *testing.T) {
+	tests := []struct {
+		path      string
+		mount     string
+		wantMount string
+		wantName  string
+		wantErr   bool
+	}{
+		{
+			path:      "kvv2/data/foo",
+			wantMount: "kvv2",
+			wantName:  "foo",
+		},
+		{
+			path:      "ns/kvv2/data/foo/bar/data/baz",
+			wantMount: "ns/kvv2",
+			wantName:  "foo/bar/data/baz",
+		},
+		{
+			path:      "team/data/kvv2/data/foo/bar",
+			mount:     "team/data/kvv2/",
+			wantMount: "team/data/kvv2",
+			wantName:  "foo/bar",
+		},
+		{
+			path:    "team/data/kvv2/data/foo",
+			mount:   "other",
+			wantErr: true,
+		},
+		{
+			path:    "kvv2/metadata/foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			mount, name, err := kvSecretV2MountAndNameFromPath(tt.path, tt.mount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("kvSecretV2MountAndNameFromPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mount != tt.wantMount {
+				t.Errorf("expected mount %q, actual %q", tt.wantMount, mount)
+			}
+			if name != tt.wantName {
+				t.Errorf("expected name %q, actual %q", tt.wantName, name)
+			}
+		})
+	}
+}
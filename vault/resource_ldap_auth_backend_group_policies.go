@@ -0,0 +1,198 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func ldapAuthBackendGroupPoliciesResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapAuthBackendGroupPoliciesUpdate,
+		Update: ldapAuthBackendGroupPoliciesUpdate,
+		Read:   ldapAuthBackendGroupPoliciesRead,
+		Delete: ldapAuthBackendGroupPoliciesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"groupname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The LDAP groupname.",
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Policies to be tied to the group.",
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Should the resource manage policies exclusively? Beware of race conditions when disabling exclusive management",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "Path to the authentication backend.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+		},
+	}
+}
+
+func readLDAPAuthBackendGroupPolicies(client *api.Client, path string) ([]interface{}, bool, error) {
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading ldap group %q: %s", path, err)
+	}
+	if resp == nil {
+		return nil, false, nil
+	}
+
+	if v, ok := resp.Data["policies"]; ok && v != nil {
+		return v.([]interface{}), true, nil
+	}
+
+	return make([]interface{}, 0), true, nil
+}
+
+func ldapAuthBackendGroupPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	groupname := d.Get("groupname").(string)
+	path := ldapAuthBackendGroupResourcePath(backend, groupname)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	log.Printf("[DEBUG] Updating LDAP group policies %q", path)
+
+	data := make(map[string]interface{})
+	policies := d.Get("policies").(*schema.Set).List()
+
+	if d.Get("exclusive").(bool) {
+		data["policies"] = policies
+	} else {
+		apiPolicies, _, err := readLDAPAuthBackendGroupPolicies(client, path)
+		if err != nil {
+			return err
+		}
+		if d.HasChange("policies") {
+			oldPoliciesI, _ := d.GetChange("policies")
+			for _, policy := range oldPoliciesI.(*schema.Set).List() {
+				apiPolicies = util.SliceRemoveIfPresent(apiPolicies, policy)
+			}
+		}
+		for _, policy := range policies {
+			apiPolicies = util.SliceAppendIfMissing(apiPolicies, policy)
+		}
+		data["policies"] = apiPolicies
+	}
+
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating LDAP group policies %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated LDAP group policies %q", path)
+
+	d.SetId(path)
+
+	return ldapAuthBackendGroupPoliciesRead(d, meta)
+}
+
+func ldapAuthBackendGroupPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := ldapAuthBackendGroupBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for LDAP auth backend group: %s", path, err)
+	}
+
+	groupname, err := ldapAuthBackendGroupNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for LDAP auth backend group: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP group policies %q", path)
+	apiPolicies, found, err := readLDAPAuthBackendGroupPolicies(client, path)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Read LDAP group policies %q", path)
+
+	if !found {
+		log.Printf("[WARN] LDAP group %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("exclusive").(bool) {
+		if err := d.Set("policies", apiPolicies); err != nil {
+			return fmt.Errorf("error setting policies for LDAP group %q: %s", path, err)
+		}
+	} else {
+		newPolicies := make([]string, 0)
+		for _, policy := range d.Get("policies").(*schema.Set).List() {
+			if found, _ := util.SliceHasElement(apiPolicies, policy); found {
+				newPolicies = append(newPolicies, policy.(string))
+			}
+		}
+		if err := d.Set("policies", newPolicies); err != nil {
+			return fmt.Errorf("error setting policies for LDAP group %q: %s", path, err)
+		}
+	}
+
+	d.Set("backend", backend)
+	d.Set("groupname", groupname)
+
+	return nil
+}
+
+func ldapAuthBackendGroupPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	log.Printf("[DEBUG] Deleting LDAP group policies %q", path)
+
+	data := make(map[string]interface{})
+
+	if d.Get("exclusive").(bool) {
+		data["policies"] = make([]string, 0)
+	} else {
+		apiPolicies, found, err := readLDAPAuthBackendGroupPolicies(client, path)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return nil
+		}
+		for _, policy := range d.Get("policies").(*schema.Set).List() {
+			apiPolicies = util.SliceRemoveIfPresent(apiPolicies, policy)
+		}
+		data["policies"] = apiPolicies
+	}
+
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating LDAP group policies %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP group policies %q", path)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestLDAPAuthBackendGroupPolicies_exclusive(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap-backend")
+	groupname := acctest.RandomWithPrefix("tf-test-ldap-group")
+	resourceName := "vault_ldap_auth_backend_group_policies.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendGroupPoliciesConfig_exclusive(backend, groupname, []string{"dev", "test"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/groups/"+groupname),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+					testLDAPAuthBackendGroupPoliciesCheckLogical(backend, groupname, []string{"dev", "test"}),
+				),
+			},
+			{
+				Config: testLDAPAuthBackendGroupPoliciesConfig_exclusive(backend, groupname, []string{"dev"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policies.0", "dev"),
+					testLDAPAuthBackendGroupPoliciesCheckLogical(backend, groupname, []string{"dev"}),
+				),
+			},
+		},
+	})
+}
+
+func TestLDAPAuthBackendGroupPolicies_nonExclusive(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap-backend")
+	groupname := acctest.RandomWithPrefix("tf-test-ldap-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendGroupPoliciesConfig_nonExclusive(backend, groupname, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.dev", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.dev", "policies.0", "dev"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.test", "policies.0", "test"),
+					testLDAPAuthBackendGroupPoliciesCheckLogical(backend, groupname, []string{"dev", "test"}),
+				),
+			},
+			{
+				Config: testLDAPAuthBackendGroupPoliciesConfig_nonExclusive(backend, groupname, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.dev", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.dev", "policies.0", "dev"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_group_policies.test", "policies.0", "foo"),
+					testLDAPAuthBackendGroupPoliciesCheckLogical(backend, groupname, []string{"dev", "foo"}),
+				),
+			},
+		},
+	})
+}
+
+func testLDAPAuthBackendGroupPoliciesCheckLogical(backend, groupname string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := ldapAuthBackendGroupResourcePath(backend, groupname)
+		apiPolicies, found, err := readLDAPAuthBackendGroupPolicies(client, path)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("ldap group %q not found", path)
+		}
+
+		if len(apiPolicies) != len(expected) {
+			return fmt.Errorf("expected %d policies on %q, got %#v", len(expected), path, apiPolicies)
+		}
+		for _, policy := range expected {
+			if found, _ := util.SliceHasElement(apiPolicies, policy); !found {
+				return fmt.Errorf("expected policy %q on %q, got %#v", policy, path, apiPolicies)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testLDAPAuthBackendGroupPoliciesConfig_exclusive(backend, groupname string, policies []string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "ldap" {
+    path = "%s"
+    type = "ldap"
+}
+
+resource "vault_ldap_auth_backend_group_policies" "test" {
+    backend   = vault_auth_backend.ldap.path
+    groupname = "%s"
+    policies  = %s
+    exclusive = true
+}
+`, backend, groupname, util.ArrayToTerraformList(policies))
+}
+
+func testLDAPAuthBackendGroupPoliciesConfig_nonExclusive(backend, groupname, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "ldap" {
+    path = "%s"
+    type = "ldap"
+}
+
+resource "vault_ldap_auth_backend_group_policies" "dev" {
+    backend   = vault_auth_backend.ldap.path
+    groupname = "%s"
+    policies  = ["dev"]
+}
+
+resource "vault_ldap_auth_backend_group_policies" "test" {
+    backend   = vault_ldap_auth_backend_group_policies.dev.backend
+    groupname = vault_ldap_auth_backend_group_policies.dev.groupname
+    policies  = ["%s"]
+}
+`, backend, groupname, policy)
+}
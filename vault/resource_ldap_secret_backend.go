@@ -0,0 +1,290 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// ldapSecretBackendConfigFields are the fields written to and read back from
+// the backend's config endpoint. bindpass is never returned by Vault so it is
+// handled separately.
+var ldapSecretBackendConfigFields = []string{
+	"binddn",
+	"url",
+	"userdn",
+	"userattr",
+	"schema",
+	"password_policy",
+	"certificate",
+	"request_timeout",
+}
+
+func ldapSecretBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Default:     "ldap",
+			ForceNew:    true,
+			Optional:    true,
+			Description: "The mount path for the LDAP backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the mount for the backend.",
+		},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Default lease duration for secrets in seconds.",
+		},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum possible lease duration for secrets in seconds.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+		},
+		"binddn": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Distinguished name of the object to bind as when managing the service accounts.",
+		},
+		"bindpass": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Password to use along with binddn when managing the service accounts.",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The LDAP server to connect to. Examples: ldaps://ldap.myorg.com, ldaps://ldap.myorg.com:636. Multiple URLs can be given, separated by commas.",
+		},
+		"userdn": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Base DN under which to perform user search.",
+		},
+		"userattr": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Attribute used when searching users.",
+		},
+		"schema": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "openldap",
+			Description:  "The LDAP schema to use when storing entry passwords; one of 'openldap', 'ad' or 'racf'.",
+			ValidateFunc: validation.StringInSlice([]string{"openldap", "ad", "racf"}, false),
+		},
+		"password_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the password policy to use to generate passwords.",
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "CA certificate to use when verifying LDAP server certificate, must be x509 PEM encoded.",
+		},
+		"starttls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+		},
+		"insecure_tls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Skip LDAP server SSL Certificate verification. Insecure and not recommended for production use.",
+		},
+		"request_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Timeout, in seconds, for the connection when making requests against the server before returning back an error.",
+		},
+	}
+
+	return &schema.Resource{
+		Create: ldapSecretBackendCreate,
+		Update: ldapSecretBackendUpdate,
+		Read:   ldapSecretBackendRead,
+		Delete: ldapSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func ldapSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+
+	log.Printf("[DEBUG] Mounting LDAP backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "ldap",
+		Description: d.Get("description").(string),
+		Local:       d.Get("local").(bool),
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds").(int)),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted LDAP backend at %q", backend)
+
+	d.SetId(backend)
+
+	if err := ldapSecretBackendWriteConfig(d, client, backend); err != nil {
+		return err
+	}
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChanges("default_lease_ttl_seconds", "max_lease_ttl_seconds", "description") {
+		description := d.Get("description").(string)
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds").(int)),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
+			Description:     &description,
+		}
+
+		log.Printf("[DEBUG] Tuning LDAP backend %q", backend)
+		if err := client.Sys().TuneMount(backend, tune); err != nil {
+			return fmt.Errorf("error tuning LDAP backend %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Tuned LDAP backend %q", backend)
+	}
+
+	if err := ldapSecretBackendWriteConfig(d, client, backend); err != nil {
+		return err
+	}
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendWriteConfig(d *schema.ResourceData, client *api.Client, backend string) error {
+	configPath := ldapSecretBackendConfigPath(backend)
+
+	data := map[string]interface{}{
+		"bindpass":     d.Get("bindpass").(string),
+		"starttls":     d.Get("starttls").(bool),
+		"insecure_tls": d.Get("insecure_tls").(bool),
+	}
+	for _, k := range ldapSecretBackendConfigFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return nil
+}
+
+func ldapSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Reading %q", backend)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read %q", backend)
+
+	// the API always returns the path with a trailing slash
+	mount, ok := mounts[strings.Trim(backend, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] %q not found, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", backend); err != nil {
+		return err
+	}
+	if err := d.Set("description", mount.Description); err != nil {
+		return err
+	}
+	if err := d.Set("local", mount.Local); err != nil {
+		return err
+	}
+	if err := d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL); err != nil {
+		return err
+	}
+	if err := d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL); err != nil {
+		return err
+	}
+
+	configPath := ldapSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	// bindpass is not returned by Vault.
+	for _, k := range append(ldapSecretBackendConfigFields, "starttls", "insecure_tls") {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting LDAP backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		return fmt.Errorf("error unmounting LDAP backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted LDAP backend %q", backend)
+
+	return nil
+}
+
+func ldapSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
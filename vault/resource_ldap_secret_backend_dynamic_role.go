@@ -0,0 +1,166 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var (
+	ldapSecretBackendDynamicRoleIDRegex = regexp.MustCompile("^(.+)/role/(.+)$")
+
+	ldapSecretBackendDynamicRoleFields = []string{
+		"creation_ldif",
+		"deletion_ldif",
+		"rollback_ldif",
+		"username_template",
+		"default_ttl",
+		"max_ttl",
+	}
+)
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendDynamicRoleWrite,
+		Update: ldapSecretBackendDynamicRoleWrite,
+		Read:   ldapSecretBackendDynamicRoleRead,
+		Delete: ldapSecretBackendDynamicRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The mount path for the LDAP backend.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"creation_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A templatized LDIF string used to create a user account.",
+			},
+			"deletion_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A templatized LDIF string used to delete the user account once its TTL has expired.",
+			},
+			"rollback_ldif": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A templatized LDIF string used to attempt to rollback any changes in the event that execution of the creation_ldif results in an error.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A template used to generate a dynamic username.",
+			},
+			"default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Specifies the TTL for the leases associated with this role, in seconds.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Specifies the maximum TTL for the leases associated with this role, in seconds.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	roleName := d.Get("role_name").(string)
+	path := backend + "/role/" + roleName
+
+	data := map[string]interface{}{}
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		data[k] = d.Get(k)
+	}
+	// Leave Vault's default template in place when none is configured.
+	if _, ok := d.GetOk("username_template"); !ok {
+		delete(data, "username_template")
+	}
+
+	log.Printf("[DEBUG] Writing LDAP dynamic role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP dynamic role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendDynamicRoleRead(d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	res := ldapSecretBackendDynamicRoleIDRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid LDAP dynamic role ID %q, expected <backend>/role/<role_name>", path)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP dynamic role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP dynamic role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] LDAP dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", res[1]); err != nil {
+		return err
+	}
+	if err := d.Set("role_name", res[2]); err != nil {
+		return err
+	}
+
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on LDAP dynamic role %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP dynamic role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP dynamic role %q", path)
+
+	return nil
+}
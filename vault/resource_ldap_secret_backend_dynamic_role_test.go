@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestLDAPSecretBackendDynamicRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	resourceName := "vault_ldap_secret_backend_dynamic_role.test"
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendDynamicRole_config(backend, bindDN, bindPass, url, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_ldif"),
+					resource.TestCheckResourceAttrSet(resourceName, "deletion_ldif"),
+					resource.TestCheckResourceAttrSet(resourceName, "rollback_ldif"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "7200"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendDynamicRole_config(backend, bindDN, bindPass, url, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testLDAPSecretBackendDynamicRole_config(backend, bindDN, bindPass, url string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = true
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend       = vault_ldap_secret_backend.test.backend
+  role_name     = "test"
+  creation_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: {{.Username}}
+sn: {{.Username}}
+userPassword: {{.Password}}
+EOT
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+  rollback_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+  default_ttl   = %d
+  max_ttl       = 7200
+}
+`, backend, bindDN, bindPass, url, defaultTTL)
+}
@@ -0,0 +1,139 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var ldapSecretBackendStaticRoleIDRegex = regexp.MustCompile("^(.+)/static-role/(.+)$")
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendStaticRoleWrite,
+		Update: ldapSecretBackendStaticRoleWrite,
+		Read:   ldapSecretBackendStaticRoleRead,
+		Delete: ldapSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ldap",
+				Description: "The mount path for the LDAP backend.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing LDAP entry to manage password rotation for.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name of the existing LDAP entry to manage password rotation for.",
+			},
+			"rotation_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "How often Vault should rotate the password of the user entry, in seconds.",
+				ValidateFunc: validation.IntAtLeast(5),
+			},
+		},
+	}
+}
+
+func ldapSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	roleName := d.Get("role_name").(string)
+	path := backend + "/static-role/" + roleName
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"dn":              d.Get("dn").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+
+	log.Printf("[DEBUG] Writing LDAP static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP static role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	res := ldapSecretBackendStaticRoleIDRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid LDAP static role ID %q, expected <backend>/static-role/<role_name>", path)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP static role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] LDAP static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", res[1]); err != nil {
+		return err
+	}
+	if err := d.Set("role_name", res[2]); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"username", "dn", "rotation_period"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on LDAP static role %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP static role %q", path)
+
+	return nil
+}
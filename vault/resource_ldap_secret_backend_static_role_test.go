@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestLDAPSecretBackendStaticRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	resourceName := "vault_ldap_secret_backend_static_role.test"
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+	v := testutil.SkipTestEnvUnset(t, "LDAP_STATIC_USERNAME", "LDAP_STATIC_DN")
+	username, dn := v[0], v[1]
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendStaticRole_config(backend, bindDN, bindPass, url, username, dn, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "dn", dn),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "60"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendStaticRole_config(backend, bindDN, bindPass, url, username, dn, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testLDAPSecretBackendStaticRole_config(backend, bindDN, bindPass, url, username, dn string, rotationPeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = true
+}
+
+resource "vault_ldap_secret_backend_static_role" "test" {
+  backend         = vault_ldap_secret_backend.test.backend
+  role_name       = "test"
+  username        = "%s"
+  dn              = "%s"
+  rotation_period = %d
+}
+`, backend, bindDN, bindPass, url, username, dn, rotationPeriod)
+}
@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestLDAPSecretBackend(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	resourceName := "vault_ldap_secret_backend.test"
+	bindDN, bindPass, url := testutil.GetTestLDAPCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackend_config(backend, bindDN, bindPass, url, 3600, "ou=users,dc=example,dc=org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "local", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "binddn", bindDN),
+					resource.TestCheckResourceAttr(resourceName, "url", url),
+					resource.TestCheckResourceAttr(resourceName, "userdn", "ou=users,dc=example,dc=org"),
+					resource.TestCheckResourceAttr(resourceName, "schema", "openldap"),
+					resource.TestCheckResourceAttr(resourceName, "insecure_tls", "true"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackend_config(backend, bindDN, bindPass, url, 7200, "ou=people,dc=example,dc=org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "userdn", "ou=people,dc=example,dc=org"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass"},
+			},
+		},
+	})
+}
+
+func testLDAPSecretBackend_config(backend, bindDN, bindPass, url string, defaultTTL int, userDN string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  backend                   = "%s"
+  description               = "test description"
+  default_lease_ttl_seconds = %d
+  binddn                    = "%s"
+  bindpass                  = "%s"
+  url                       = "%s"
+  userdn                    = "%s"
+  insecure_tls              = true
+}
+`, backend, defaultTTL, bindDN, bindPass, url, userDN)
+}
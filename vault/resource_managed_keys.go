@@ -0,0 +1,470 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const managedKeysID = "default"
+
+// managedKeysType maps a nested block of the vault_managed_keys resource to
+// the Vault managed key type it configures.
+type managedKeysType struct {
+	blockName string
+	keyType   string
+	// sensitiveFields are never returned by Vault, and are
+	// preserved from the previous state on read.
+	sensitiveFields []string
+	schema          func() *schema.Resource
+}
+
+var managedKeysTypes = []*managedKeysType{
+	{
+		blockName:       "pkcs11",
+		keyType:         "pkcs11",
+		sensitiveFields: []string{"pin"},
+		schema:          managedKeysPKCS11Schema,
+	},
+	{
+		blockName:       "aws",
+		keyType:         "awskms",
+		sensitiveFields: []string{"access_key", "secret_key"},
+		schema:          managedKeysAWSSchema,
+	},
+	{
+		blockName:       "azure",
+		keyType:         "azurekeyvault",
+		sensitiveFields: []string{"client_secret"},
+		schema:          managedKeysAzureSchema,
+	},
+}
+
+func managedKeysResource() *schema.Resource {
+	s := map[string]*schema.Schema{}
+	for _, t := range managedKeysTypes {
+		s[t.blockName] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: fmt.Sprintf("Configuration block for %s managed keys.", t.keyType),
+			Elem:        t.schema(),
+		}
+	}
+
+	return &schema.Resource{
+		Create: managedKeysCreate,
+		Read:   managedKeysRead,
+		Update: managedKeysUpdate,
+		Delete: managedKeysDelete,
+		Importer: &schema.ResourceImporter{
+			State: managedKeysImport,
+		},
+		Schema: s,
+	}
+}
+
+func managedKeysCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A unique lowercase name that serves as identifying the key.",
+		},
+		"allow_generate_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If no existing key can be found in the referenced backend, instructs Vault to generate a key within the backend.",
+		},
+		"allow_replace_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Controls the ability for Vault to replace through generation or importing a key into the configured backend even if a key is present.",
+		},
+		"allow_store_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Controls the ability for Vault to import a key to the configured backend.",
+		},
+		"any_mount": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Allow usage from any mount point within the namespace if 'true'.",
+		},
+	}
+}
+
+func managedKeysPKCS11Schema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["library"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the kms_library stanza to use from Vault's config to lookup the local library path.",
+	}
+	s["key_label"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The label of the key to use.",
+	}
+	s["key_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The id of a PKCS#11 key to use.",
+	}
+	s["mechanism"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The encryption/decryption mechanism to use, specified as a hexadecimal (prefixed by 0x) string.",
+	}
+	s["pin"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The PIN for login.",
+	}
+	s["slot"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The slot number to use, specified as a string in a decimal format (e.g. '2305843009213693953').",
+	}
+	s["token_label"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The slot token label to use.",
+	}
+	s["curve"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Supplies the curve value when using the 'CKM_ECDSA' mechanism.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Supplies the size in bits of the key when using 'CKM_RSA_PKCS_PSS', 'CKM_RSA_PKCS_OAEP' or 'CKM_RSA_PKCS' as a value for 'mechanism'.",
+	}
+	s["force_rw_session"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Force all operations to open up a read-write session to the HSM.",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysAWSSchema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["access_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The AWS access key to use.",
+	}
+	s["secret_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The AWS secret key to use.",
+	}
+	s["kms_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "An identifier for the key.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The size in bits for an RSA key.",
+	}
+	s["key_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of key to use.",
+	}
+	s["curve"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The curve to use for an ECDSA key. Used when key_type is 'ECDSA'.",
+	}
+	s["endpoint"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Used to specify a custom AWS endpoint.",
+	}
+	s["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The AWS region where the keys are stored (or will be stored).",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysAzureSchema() *schema.Resource {
+	s := managedKeysCommonSchema()
+	s["tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The tenant id for the Azure Active Directory organization.",
+	}
+	s["client_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The client id for credentials to query the Azure APIs.",
+	}
+	s["client_secret"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The client secret for credentials to query the Azure APIs.",
+	}
+	s["vault_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The Key Vault vault to use the encryption keys for encryption and decryption.",
+	}
+	s["key_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The Key Vault key to use for encryption and decryption.",
+	}
+	s["key_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The type of key to use.",
+	}
+	s["key_bits"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The size in bits for an RSA key.",
+	}
+	s["environment"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The Azure Cloud environment API endpoints to use.",
+	}
+	s["resource"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The Azure Key Vault resource's DNS Suffix to connect to.",
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func managedKeysCreate(d *schema.ResourceData, meta interface{}) error {
+	// set the ID up front so that the keys already written are tracked in
+	// the state should a later key fail to be written.
+	d.SetId(managedKeysID)
+	if err := managedKeysWrite(d, meta); err != nil {
+		return managedKeysWriteFailed(d, meta, err)
+	}
+
+	return managedKeysRead(d, meta)
+}
+
+// managedKeysWriteFailed refreshes the state after a partially failed write,
+// dropping the keys that were never written, and returns err.
+func managedKeysWriteFailed(d *schema.ResourceData, meta interface{}, err error) error {
+	if readErr := managedKeysRead(d, meta); readErr != nil {
+		log.Printf("[WARN] Failed to refresh managed keys after a failed write: %s", readErr)
+	}
+	return err
+}
+
+func managedKeysImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	for _, t := range managedKeysTypes {
+		path := fmt.Sprintf("sys/managed-keys/%s", t.keyType)
+
+		log.Printf("[DEBUG] Listing managed keys %q", path)
+		resp, err := client.Logical().List(path)
+		if err != nil {
+			return nil, fmt.Errorf("error listing managed keys %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Listed managed keys %q", path)
+
+		var keys []interface{}
+		if resp != nil {
+			names, _ := resp.Data["keys"].([]interface{})
+			for _, name := range names {
+				keys = append(keys, map[string]interface{}{"name": name})
+			}
+		}
+		if err := d.Set(t.blockName, keys); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(managedKeysID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func managedKeysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for _, t := range managedKeysTypes {
+		res := t.schema()
+		var keys []interface{}
+		for _, v := range d.Get(t.blockName).(*schema.Set).List() {
+			key := v.(map[string]interface{})
+			path := managedKeysPath(t.keyType, key["name"].(string))
+
+			log.Printf("[DEBUG] Reading managed key %q", path)
+			resp, err := client.Logical().Read(path)
+			if err != nil {
+				return fmt.Errorf("error reading managed key %q: %s", path, err)
+			}
+			log.Printf("[DEBUG] Read managed key %q", path)
+			if resp == nil {
+				log.Printf("[WARN] Managed key %q not found, removing from state", path)
+				continue
+			}
+
+			for k, s := range res.Schema {
+				if k == "name" || managedKeysIsSensitive(t, k) {
+					continue
+				}
+				v, ok := resp.Data[k]
+				if !ok || v == nil {
+					continue
+				}
+				val, err := managedKeysFlattenValue(s.Type, v)
+				if err != nil {
+					return fmt.Errorf("error reading %s for managed key %q: %s", k, path, err)
+				}
+				key[k] = val
+			}
+			keys = append(keys, key)
+		}
+
+		if err := d.Set(t.blockName, keys); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func managedKeysUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := managedKeysWrite(d, meta); err != nil {
+		return managedKeysWriteFailed(d, meta, err)
+	}
+
+	return managedKeysRead(d, meta)
+}
+
+func managedKeysDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for _, t := range managedKeysTypes {
+		for _, v := range d.Get(t.blockName).(*schema.Set).List() {
+			name := v.(map[string]interface{})["name"].(string)
+			if err := managedKeysDeleteKey(client, t.keyType, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// managedKeysWrite deletes the keys that were removed from each block, and
+// writes the remaining ones.
+func managedKeysWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	for _, t := range managedKeysTypes {
+		o, n := d.GetChange(t.blockName)
+
+		names := map[string]bool{}
+		for _, v := range n.(*schema.Set).List() {
+			names[v.(map[string]interface{})["name"].(string)] = true
+		}
+
+		for _, v := range o.(*schema.Set).List() {
+			name := v.(map[string]interface{})["name"].(string)
+			if names[name] {
+				continue
+			}
+			if err := managedKeysDeleteKey(client, t.keyType, name); err != nil {
+				return err
+			}
+		}
+
+		res := t.schema()
+		for _, v := range n.(*schema.Set).List() {
+			key := v.(map[string]interface{})
+			path := managedKeysPath(t.keyType, key["name"].(string))
+
+			data := map[string]interface{}{}
+			for k := range res.Schema {
+				if k == "name" {
+					continue
+				}
+				if val, ok := key[k]; ok && val != "" {
+					data[k] = val
+				}
+			}
+
+			log.Printf("[DEBUG] Writing managed key %q", path)
+			if _, err := client.Logical().Write(path, data); err != nil {
+				return fmt.Errorf("error writing managed key %q: %s", path, err)
+			}
+			log.Printf("[DEBUG] Wrote managed key %q", path)
+		}
+	}
+
+	return nil
+}
+
+func managedKeysDeleteKey(client *api.Client, keyType, name string) error {
+	path := managedKeysPath(keyType, name)
+
+	log.Printf("[DEBUG] Deleting managed key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting managed key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted managed key %q", path)
+
+	return nil
+}
+
+func managedKeysIsSensitive(t *managedKeysType, field string) bool {
+	for _, f := range t.sensitiveFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// managedKeysFlattenValue converts a value returned by Vault to the type
+// expected by the schema. Vault may return numeric values for fields that
+// are strings on write, e.g. key_bits.
+func managedKeysFlattenValue(t schema.ValueType, v interface{}) (interface{}, error) {
+	switch t {
+	case schema.TypeBool:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", v)
+		}
+		return b, nil
+	case schema.TypeString:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case json.Number:
+			return v.String(), nil
+		case bool:
+			return fmt.Sprintf("%t", v), nil
+		}
+		return nil, fmt.Errorf("expected a string, got %T", v)
+	}
+
+	return nil, fmt.Errorf("unsupported schema type %s", t)
+}
+
+func managedKeysPath(keyType, name string) string {
+	return fmt.Sprintf("sys/managed-keys/%s/%s", keyType, name)
+}
@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestManagedKeysFlattenValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       schema.ValueType
+		v       interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "string",
+			t:    schema.TypeString,
+			v:    "RSA",
+			want: "RSA",
+		},
+		{
+			name: "number-as-string",
+			t:    schema.TypeString,
+			v:    json.Number("2048"),
+			want: "2048",
+		},
+		{
+			name: "bool-as-string",
+			t:    schema.TypeString,
+			v:    true,
+			want: "true",
+		},
+		{
+			name: "bool",
+			t:    schema.TypeBool,
+			v:    true,
+			want: true,
+		},
+		{
+			name:    "invalid-bool",
+			t:       schema.TypeBool,
+			v:       "true",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := managedKeysFlattenValue(tt.t, tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("managedKeysFlattenValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("managedKeysFlattenValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagedKeys_aws(t *testing.T) {
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	region := testutil.GetTestAWSRegion(t)
+	kmsKey := testutil.SkipTestEnvUnset(t, "AWS_KMS_KEY_ID")[0]
+
+	name := acctest.RandomWithPrefix("aws-keys")
+	resourceName := "vault_managed_keys.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testManagedKeysCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeysConfig_aws(name, accessKey, secretKey, region, kmsKey, "2048"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "aws.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "aws.*", map[string]string{
+						"name":     name,
+						"kms_key":  kmsKey,
+						"key_bits": "2048",
+						"key_type": "RSA",
+						"region":   region,
+					}),
+				),
+			},
+			{
+				Config: testManagedKeysConfig_aws(name, accessKey, secretKey, region, kmsKey, "4096"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "aws.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "aws.*", map[string]string{
+						"name":     name,
+						"key_bits": "4096",
+					}),
+				),
+			},
+			{
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateCheck: testManagedKeysImportStateCheck("aws", name, kmsKey),
+			},
+		},
+	})
+}
+
+// testManagedKeysImportStateCheck checks that the imported state contains a
+// block of blockName with the expected key. The credentials cannot be read
+// back from Vault, so ImportStateVerify cannot be used for set elements.
+func testManagedKeysImportStateCheck(blockName, name, kmsKey string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+
+		attrs := states[0].Attributes
+		if states[0].ID != managedKeysID {
+			return fmt.Errorf("expected ID %q, got %q", managedKeysID, states[0].ID)
+		}
+		for k, v := range attrs {
+			if !strings.HasPrefix(k, blockName+".") || !strings.HasSuffix(k, ".name") || v != name {
+				continue
+			}
+			prefix := strings.TrimSuffix(k, "name")
+			if attrs[prefix+"kms_key"] != kmsKey {
+				return fmt.Errorf("expected kms_key %q for imported key %q, got %q", kmsKey, name, attrs[prefix+"kms_key"])
+			}
+			return nil
+		}
+
+		return fmt.Errorf("managed key %q not found in imported state %#v", name, attrs)
+	}
+}
+
+func testManagedKeysCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_managed_keys" {
+			continue
+		}
+		for _, t := range managedKeysTypes {
+			resp, err := client.Logical().List(fmt.Sprintf("sys/managed-keys/%s", t.keyType))
+			if err != nil {
+				return err
+			}
+			if resp == nil {
+				continue
+			}
+			for k, v := range rs.Primary.Attributes {
+				if v == "" || !strings.HasSuffix(k, ".name") {
+					continue
+				}
+				if keys, ok := resp.Data["keys"].([]interface{}); ok {
+					for _, key := range keys {
+						if key == v {
+							return fmt.Errorf("managed key %q still exists", v)
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testManagedKeysConfig_aws(name, accessKey, secretKey, region, kmsKey, keyBits string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_keys" "test" {
+  aws {
+    name       = "%s"
+    access_key = "%s"
+    secret_key = "%s"
+    region     = "%s"
+    kms_key    = "%s"
+    key_bits   = "%s"
+    key_type   = "RSA"
+  }
+}
+`, name, accessKey, secretKey, region, kmsKey, keyBits)
+}
@@ -67,6 +67,27 @@ func getMountSchema(excludes ...string) schemaMap {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
 
+		"allowed_response_headers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of headers to allow, allowing a plugin to include them in the response",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
+		"passthrough_request_headers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of headers to allow and pass from the request to the plugin",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
+		"allowed_managed_keys": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of managed key registry entry names that the mount in question is allowed to access",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
 		"accessor": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -107,6 +128,14 @@ func getMountSchema(excludes ...string) schemaMap {
 			ForceNew:    true,
 			Description: "Enable the secrets engine to access Vault's external entropy source",
 		},
+
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "Prevent the mount from being disabled when the resource is destroyed. " +
+				"Must be set to false and applied before the mount can be removed",
+		},
 	}
 	for _, v := range excludes {
 		delete(s, v)
@@ -115,6 +144,9 @@ func getMountSchema(excludes ...string) schemaMap {
 }
 
 func MountResource() *schema.Resource {
+	s := getMountSchema()
+	s[fieldNamespace] = namespaceSchema()
+
 	return &schema.Resource{
 		Create: mountWrite,
 		Update: mountUpdate,
@@ -123,12 +155,15 @@ func MountResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Schema: getMountSchema(),
+		Schema: s,
 	}
 }
 
 func mountWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Get("path").(string)
 	if err := createMount(d, client, path, d.Get("type").(string)); err != nil {
@@ -160,6 +195,15 @@ func createMount(d *schema.ResourceData, client *api.Client, path string, mountT
 	if v, ok := d.GetOk("audit_non_hmac_response_keys"); ok {
 		input.Config.AuditNonHMACResponseKeys = expandStringSlice(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("allowed_response_headers"); ok {
+		input.Config.AllowedResponseHeaders = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("passthrough_request_headers"); ok {
+		input.Config.PassthroughRequestHeaders = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("allowed_managed_keys"); ok {
+		input.Config.AllowedManagedKeys = expandStringSlice(v.([]interface{}))
+	}
 
 	log.Printf("[DEBUG] Creating mount %s in Vault", path)
 
@@ -171,7 +215,10 @@ func createMount(d *schema.ResourceData, client *api.Client, path string, mountT
 }
 
 func mountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	config := api.MountConfigInput{
 		DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
@@ -192,6 +239,24 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		config.Description = &description
 	}
 
+	// MountConfigInput omits empty header lists, so any list that was removed
+	// from the config must be cleared with an explicit write to the tune endpoint.
+	clearedTuneFields := map[string]interface{}{}
+	for k, v := range map[string]*[]string{
+		"allowed_response_headers":    &config.AllowedResponseHeaders,
+		"passthrough_request_headers": &config.PassthroughRequestHeaders,
+		"allowed_managed_keys":        &config.AllowedManagedKeys,
+	} {
+		if !d.HasChange(k) {
+			continue
+		}
+		if vals := expandStringSlice(d.Get(k).([]interface{})); len(vals) > 0 {
+			*v = vals
+		} else {
+			clearedTuneFields[k] = []string{}
+		}
+	}
+
 	path := d.Id()
 
 	if d.HasChange("path") {
@@ -199,9 +264,8 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Remount %s to %s in Vault", path, newPath)
 
-		err := client.Sys().Remount(d.Id(), newPath)
-		if err != nil {
-			return fmt.Errorf("error remounting in Vault: %s", err)
+		if err := remountAndWait(client, path, newPath); err != nil {
+			return err
 		}
 
 		d.SetId(newPath)
@@ -224,14 +288,83 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		break
 	}
 
+	if len(clearedTuneFields) > 0 {
+		log.Printf("[DEBUG] Clearing mount tune fields for %s in Vault", path)
+		if _, err := client.Logical().Write(fmt.Sprintf("sys/mounts/%s/tune", path), clearedTuneFields); err != nil {
+			return fmt.Errorf("error updating Vault: %s", err)
+		}
+	}
+
 	return mountRead(d, meta)
 }
 
+const (
+	remountStatusPollInterval = 1 * time.Second
+	remountTimeout            = 5 * time.Minute
+)
+
+// remountAndWait moves the mount at from to to. Since Vault 1.10 the remount
+// runs in the background, so poll its migration status until it completes.
+func remountAndWait(client *api.Client, from, to string) error {
+	resp, err := client.Logical().Write("sys/remount", map[string]interface{}{
+		"from": from,
+		"to":   to,
+	})
+	if err != nil {
+		return fmt.Errorf("error remounting in Vault: %s", err)
+	}
+
+	// older versions of Vault remount synchronously and return no migration ID
+	if resp == nil || resp.Data["migration_id"] == nil {
+		return nil
+	}
+
+	migrationID := resp.Data["migration_id"].(string)
+	statusPath := "sys/remount/status/" + migrationID
+	deadline := time.Now().Add(remountTimeout)
+	for {
+		status, err := client.Logical().Read(statusPath)
+		if err != nil {
+			return fmt.Errorf("error reading remount status for migration %q: %s", migrationID, err)
+		}
+
+		var state string
+		if status != nil {
+			if info, ok := status.Data["migration_info"].(map[string]interface{}); ok {
+				state, _ = info["status"].(string)
+			}
+		}
+
+		switch state {
+		case "success":
+			log.Printf("[DEBUG] Remount %s to %s completed, migration %q", from, to, migrationID)
+			return nil
+		case "failure":
+			return fmt.Errorf("remount of %s to %s failed, migration %q", from, to, migrationID)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for remount of %s to %s, migration %q", from, to, migrationID)
+		}
+
+		log.Printf("[DEBUG] Waiting for remount %s to %s, migration %q status %q", from, to, migrationID, state)
+		time.Sleep(remountStatusPollInterval)
+	}
+}
+
 func mountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("cannot unmount %q: deletion_protection is enabled, "+
+			"set it to false and apply before destroying the mount", path)
+	}
+
 	log.Printf("[DEBUG] Unmounting %s from Vault", path)
 
 	if err := client.Sys().Unmount(path); err != nil {
@@ -246,7 +379,10 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func readMount(d *schema.ResourceData, meta interface{}, excludeType bool) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
@@ -298,6 +434,16 @@ func readMount(d *schema.ResourceData, meta interface{}, excludeType bool) error
 	d.Set("seal_wrap", mount.SealWrap)
 	d.Set("external_entropy_access", mount.ExternalEntropyAccess)
 
+	log.Printf("[DEBUG] Reading mount tune for %s from Vault", path)
+	tune, err := client.Sys().MountConfig(strings.Trim(path, "/"))
+	if err != nil {
+		return fmt.Errorf("error reading tune information from Vault: %s", err)
+	}
+
+	d.Set("allowed_response_headers", tune.AllowedResponseHeaders)
+	d.Set("passthrough_request_headers", tune.PassthroughRequestHeaders)
+	d.Set("allowed_managed_keys", tune.AllowedManagedKeys)
+
 	return nil
 }
 
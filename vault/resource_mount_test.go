@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -154,6 +155,48 @@ func TestResourceMount_AuditNonHMACRequestKeys(t *testing.T) {
 	})
 }
 
+func TestResourceMount_TuneHeaders(t *testing.T) {
+	resourcePath := "vault_mount.test"
+	path := acctest.RandomWithPrefix("example")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_TuneHeadersConfig(path, `["If-Modified-Since"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "passthrough_request_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourcePath, "passthrough_request_headers.0", "If-Modified-Since"),
+					resource.TestCheckResourceAttr(resourcePath, "allowed_response_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourcePath, "allowed_response_headers.0", "Last-Modified"),
+				),
+			},
+			{
+				// tune the mount out-of-band, expect drift to be detected
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if err := client.Sys().TuneMount(path, api.MountConfigInput{
+						PassthroughRequestHeaders: []string{"If-Modified-Since", "X-Custom"},
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testResourceMount_TuneHeadersConfig(path, `["If-Modified-Since"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testResourceMount_TuneHeadersConfig(path, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "passthrough_request_headers.#", "0"),
+					resource.TestCheckResourceAttr(resourcePath, "allowed_response_headers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestResourceMount_KVV2(t *testing.T) {
 	path := acctest.RandomWithPrefix("example")
 	kvv2Cfg := fmt.Sprintf(`
@@ -217,6 +260,46 @@ func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	})
 }
 
+func TestResourceMount_DeletionProtection(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resourceName := "vault_mount.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_ConfigDeletionProtection(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testResourceMount_ConfigDeletionProtection(path, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is enabled`),
+			},
+			{
+				Config: testResourceMount_ConfigDeletionProtection(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceMount_ConfigDeletionProtection(path string, protect bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                = "%s"
+  type                = "kv"
+  deletion_protection = %t
+}
+`, path, protect)
+}
+
 func testResourceMount_initialConfig(cfg mountConfig) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -559,6 +642,17 @@ resource "vault_mount" "test" {
 	return config + "}"
 }
 
+func testResourceMount_TuneHeadersConfig(path, passthroughRequestHeaders string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                        = "%s"
+  type                        = "pki"
+  passthrough_request_headers = %s
+  allowed_response_headers    = ["Last-Modified"]
+}
+`, path, passthroughRequestHeaders)
+}
+
 func testResourceMount_CheckAuditNonHMACRequestKeys(expectedPath string, expectedReqKeys, expectedRespKeys []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["vault_mount.test"]
@@ -679,3 +773,48 @@ func findMount(path string) (*api.MountOutput, error) {
 
 	return nil, fmt.Errorf("unable to find mount %s in Vault; current list: %v", path, mounts)
 }
+
+func TestResourceMount_RemountPreservesData(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	newPath := path + "-remounted"
+	cfg := mountConfig{
+		path:      path,
+		mountType: "kv",
+		version:   "1",
+	}
+	newCfg := cfg
+	newCfg.path = newPath
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_initialConfig(cfg),
+				Check: func(s *terraform.State) error {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(path+"/foo", map[string]interface{}{"bar": "baz"})
+					return err
+				},
+			},
+			{
+				Config: testResourceMount_initialConfig(newCfg),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "path", newPath),
+					resource.TestCheckResourceAttr("vault_mount.test", "id", newPath),
+					func(s *terraform.State) error {
+						client := testProvider.Meta().(*api.Client)
+						resp, err := client.Logical().Read(newPath + "/foo")
+						if err != nil {
+							return err
+						}
+						if resp == nil || resp.Data["bar"] != "baz" {
+							return fmt.Errorf("expected secret to be preserved at %s/foo, got %#v", newPath, resp)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
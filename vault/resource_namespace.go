@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -32,6 +33,15 @@ func namespaceResource() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the namepsace.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata describing the namespace. Requires Vault 1.12+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -41,16 +51,46 @@ func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get("path").(string)
 
-	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write("sys/namespaces/"+path, nil)
-
-	if err != nil {
-		return fmt.Errorf("error writing to Vault: %s", err)
+	if d.IsNewResource() || d.HasChange("path") {
+		data := map[string]interface{}{}
+		if v, ok := d.GetOk("custom_metadata"); ok {
+			data["custom_metadata"] = v
+		}
+
+		log.Printf("[DEBUG] Creating namespace %s in Vault", path)
+		if _, err := client.Logical().Write("sys/namespaces/"+path, data); err != nil {
+			return fmt.Errorf("error writing to Vault: %s", err)
+		}
+	} else if d.HasChange("custom_metadata") {
+		o, n := d.GetChange("custom_metadata")
+		data := map[string]interface{}{
+			"custom_metadata": namespaceCustomMetadataPatch(
+				o.(map[string]interface{}), n.(map[string]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating custom metadata on namespace %s in Vault", path)
+		if _, err := client.Logical().JSONMergePatch(context.Background(), "sys/namespaces/"+path, data); err != nil {
+			return fmt.Errorf("error updating namespace %s in Vault: %s", path, err)
+		}
 	}
 
 	return namespaceRead(d, meta)
 }
 
+// namespaceCustomMetadataPatch returns the JSON merge patch needed to go from
+// the old custom metadata to the new, keys that were removed are set to nil.
+func namespaceCustomMetadataPatch(o, n map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{}, len(n))
+	for k := range o {
+		patch[k] = nil
+	}
+	for k, v := range n {
+		patch[k] = v
+	}
+
+	return patch
+}
+
 func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -89,6 +129,13 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(resp.Data["path"].(string))
 	d.Set("namespace_id", resp.Data["id"])
 
+	// older versions of Vault do not support custom metadata
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		if err := d.Set("custom_metadata", v); err != nil {
+			return err
+		}
+	}
+
 	noTrailingSlashPath := strings.TrimSuffix(path, "/")
 	d.Set("path", noTrailingSlashPath)
 
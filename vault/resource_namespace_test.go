@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -111,3 +112,69 @@ func testNestedNamespaceCheckAttrs(expectedPath string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestNamespace_customMetadata(t *testing.T) {
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+	resourceName := "vault_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `{ cost_center = "1234", team = "ops" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.cost_center", "1234"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.team", "ops"),
+				),
+			},
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `{ cost_center = "5678" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.cost_center", "5678"),
+				),
+			},
+		},
+	})
+}
+
+func testNamespaceConfigCustomMetadata(path, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path            = %q
+  custom_metadata = %s
+}
+`, path, metadata)
+}
+
+func TestNamespaceCustomMetadataPatch(t *testing.T) {
+	tests := []struct {
+		name string
+		o    map[string]interface{}
+		n    map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "add",
+			o:    map[string]interface{}{},
+			n:    map[string]interface{}{"foo": "bar"},
+			want: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			name: "update-and-remove",
+			o:    map[string]interface{}{"foo": "bar", "baz": "qux"},
+			n:    map[string]interface{}{"foo": "quux"},
+			want: map[string]interface{}{"foo": "quux", "baz": nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := namespaceCustomMetadataPatch(tt.o, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("namespaceCustomMetadataPatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -47,10 +48,11 @@ func nomadSecretBackendRoleResource() *schema.Resource {
 			Description: `Comma separated list of Nomad policies the token is going to be created against. These need to be created beforehand in Nomad.`,
 		},
 		"type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: `Specifies the type of token to create when using this role. Valid values are "client" or "management".`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  `Specifies the type of token to create when using this role. Valid values are "client" or "management".`,
+			ValidateFunc: validation.StringInSlice([]string{"client", "management"}, false),
 		},
 	}
 	return &schema.Resource{
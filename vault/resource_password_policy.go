@@ -1,7 +1,11 @@
 package vault
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 )
 
 var passwordPolicyAttributes = []string{"policy"}
@@ -30,12 +34,71 @@ func passwordPolicyResource() *schema.Resource {
 				Required:    true,
 				Description: "The password policy document",
 			},
+
+			"validate_policy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Generate a password from the policy after writing it, failing if Vault is unable to.",
+			},
 		},
 	}
 }
 
 func resourcePasswordPolicyWrite(d *schema.ResourceData, meta interface{}) error {
-	return passwordPolicyWrite(passwordPolicyAttributes, d, meta)
+	client := meta.(*api.Client)
+	name := d.Get("name").(string)
+	validate := d.Get("validate_policy").(bool)
+
+	// Keep the current policy around, so that it can be restored if the new
+	// one fails validation.
+	var previous map[string]interface{}
+	if validate {
+		var err error
+		previous, err = readPasswordPolicy(client, name)
+		if err != nil {
+			return fmt.Errorf("error reading from Vault: %s", err)
+		}
+	}
+
+	if err := passwordPolicyWrite(passwordPolicyAttributes, d, meta); err != nil {
+		return err
+	}
+
+	if !validate {
+		return nil
+	}
+
+	if err := validatePasswordPolicy(client, name); err != nil {
+		if restoreErr := restorePasswordPolicy(client, name, previous); restoreErr != nil {
+			return fmt.Errorf("%s; additionally failed to restore the previous policy: %s", err, restoreErr)
+		}
+		if previous == nil {
+			d.SetId("")
+		} else if readErr := passwordPolicyRead(passwordPolicyAttributes, d, meta); readErr != nil {
+			return fmt.Errorf("%s; additionally failed to read back the previous policy: %s", err, readErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// restorePasswordPolicy puts back the previous policy, or deletes the policy
+// when there was none.
+func restorePasswordPolicy(client *api.Client, name string, previous map[string]interface{}) error {
+	path := fmt.Sprintf("sys/policies/password/%s", name)
+	if previous == nil {
+		log.Printf("[DEBUG] Deleting %s password policy after failed validation", name)
+		_, err := client.Logical().Delete(path)
+		return err
+	}
+
+	log.Printf("[DEBUG] Restoring previous %s password policy after failed validation", name)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"policy": previous["policy"],
+	})
+	return err
 }
 
 func resourcePasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
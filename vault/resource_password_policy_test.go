@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -33,10 +35,67 @@ func TestAccPasswordPolicy(t *testing.T) {
 					resource.TestCheckResourceAttrSet("vault_password_policy.test", "policy"),
 				),
 			},
+			{
+				Config: testAccPasswordPolicyValidated(policyName, "length = 20\nrule \"charset\" {\n  charset = \"abcde\"\n}\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_password_policy.test", "name", policyName),
+					resource.TestCheckResourceAttr("vault_password_policy.test", "validate_policy", "true"),
+				),
+			},
 		},
 	})
 }
 
+func TestAccPasswordPolicy_validationFailure(t *testing.T) {
+	policyName := acctest.RandomWithPrefix("test-policy")
+	validPolicy := "length = 20\nrule \"charset\" {\n  charset = \"abcde\"\n}\n"
+	// The charset rule requires more characters than the password is long.
+	invalidPolicy := "length = 4\nrule \"charset\" {\n  charset = \"abcde\"\n  min-chars = 5\n}\n"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccPasswordPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPasswordPolicyValidated(policyName, validPolicy),
+				Check:  resource.TestCheckResourceAttr("vault_password_policy.test", "validate_policy", "true"),
+			},
+			{
+				Config:      testAccPasswordPolicyValidated(policyName, invalidPolicy),
+				ExpectError: regexp.MustCompile(`password policy`),
+			},
+			{
+				// The failed policy must not have replaced the previous one.
+				PreConfig: func() {
+					if err := testAccPasswordPolicyCheckStored(policyName, "length = 20")(nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccPasswordPolicyValidated(policyName, validPolicy),
+				Check:  testAccPasswordPolicyCheckStored(policyName, "length = 20"),
+			},
+		},
+	})
+}
+
+func testAccPasswordPolicyCheckStored(name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		policy, err := readPasswordPolicy(client, name)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("password policy %q not found", name)
+		}
+		if !strings.Contains(policy["policy"].(string), expected) {
+			return fmt.Errorf("expected password policy %q to contain %q, got %q", name, expected, policy["policy"])
+		}
+		return nil
+	}
+}
+
 func TestAccPasswordPolicy_import(t *testing.T) {
 	policyName := acctest.RandomWithPrefix("test-policy")
 
@@ -88,3 +147,14 @@ resource "vault_password_policy" "test" {
 EOT
 }`, policyName, policy)
 }
+
+func testAccPasswordPolicyValidated(policyName string, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_password_policy" "test" {
+  name            = "%s"
+  validate_policy = true
+  policy          = <<EOT
+%s
+EOT
+}`, policyName, policy)
+}
@@ -17,7 +17,7 @@ import (
 func pkiSecretBackendCertResource() *schema.Resource {
 	return &schema.Resource{
 		Create:        pkiSecretBackendCertCreate,
-		Read:          pkiSecretBackendCertRead,
+		Read:          pkiSecretBackendIssuedCertRead,
 		Update:        pkiSecretBackendCertUpdate,
 		Delete:        pkiSecretBackendCertDelete,
 		CustomizeDiff: pkiCertAutoRenewCustomizeDiff,
@@ -77,6 +77,15 @@ func pkiSecretBackendCertResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"user_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of values to populate the userID (OID 0.9.2342.19200300.100.1.1) Subject field with.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -159,6 +168,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Default:     false,
 				Description: "Revoke the certificate upon resource destruction.",
 			},
+			"renew_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Initially false, and then set to true during refresh once the expiration is within min_seconds_remaining.",
+			},
 		},
 	}
 }
@@ -198,6 +212,12 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		otherSans = append(otherSans, iOtherSan.(string))
 	}
 
+	iUserIDs := d.Get("user_ids").([]interface{})
+	userIDs := make([]string, 0, len(iUserIDs))
+	for _, iUserID := range iUserIDs {
+		userIDs = append(userIDs, iUserID.(string))
+	}
+
 	data := map[string]interface{}{
 		"common_name":          d.Get("common_name").(string),
 		"ttl":                  d.Get("ttl").(string),
@@ -222,6 +242,10 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		data["other_sans"] = strings.Join(otherSans, ",")
 	}
 
+	if len(userIDs) > 0 {
+		data["user_ids"] = strings.Join(userIDs, ",")
+	}
+
 	log.Printf("[DEBUG] Creating certificate %s by %s on PKI secret backend %q", commonName, name, backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -243,7 +267,7 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 	d.Set("expiration", resp.Data["expiration"])
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", backend, name, commonName))
-	return pkiSecretBackendCertRead(d, meta)
+	return pkiSecretBackendIssuedCertRead(d, meta)
 }
 
 func checkPKICertExpiry(expiration int64) bool {
@@ -253,6 +277,11 @@ func checkPKICertExpiry(expiration int64) bool {
 	return now.After(expiry)
 }
 
+func pkiCertRenewPending(d *schema.ResourceData) bool {
+	expiration := int64(d.Get("expiration").(int) - d.Get("min_seconds_remaining").(int))
+	return checkPKICertExpiry(expiration)
+}
+
 func pkiCertAutoRenewCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if d.Id() == "" || !d.Get("auto_renew").(bool) {
 		return nil
@@ -293,11 +322,26 @@ func pkiSecretBackendCertRead(d *schema.ResourceData, meta interface{}) error {
 	if !enabled {
 		log.Printf("[WARN] Mount %q does not exist, setting resource for re-creation", path)
 		d.SetId("")
+		return nil
 	}
 
 	return nil
 }
 
+// pkiSecretBackendIssuedCertRead extends pkiSecretBackendCertRead with the
+// fields that only exist on vault_pki_secret_backend_cert.
+func pkiSecretBackendIssuedCertRead(d *schema.ResourceData, meta interface{}) error {
+	if err := pkiSecretBackendCertRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	return d.Set("renew_pending", pkiCertRenewPending(d))
+}
+
 func pkiSecretBackendCertUpdate(d *schema.ResourceData, m interface{}) error {
 	// TODO: add mount gone detection
 	return nil
@@ -2,10 +2,12 @@ package vault
 
 import (
 	"crypto/x509"
+	"encoding/asn1"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -77,6 +79,15 @@ func TestPkiSecretBackendCert_basic(t *testing.T) {
 					testPKICertRevocation(intermediatePath, store),
 				),
 			},
+			{
+				Config: testPkiSecretBackendCertConfig_userIDs(rootPath, intermediatePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.0", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.1", "bob"),
+					testPKICertUserIDs(resourceName, "alice", "bob"),
+				),
+			},
 		},
 	})
 }
@@ -191,6 +202,31 @@ resource "vault_pki_secret_backend_cert" "test" {
 	return strings.Join(fragments, "\n")
 }
 
+func testPkiSecretBackendCertConfig_userIDs(rootPath, intermediatePath string) string {
+	return testPkiSecretBackendCertConfig_basic(rootPath, intermediatePath, false, false) + `
+resource "vault_generic_endpoint" "user_ids" {
+  path                 = "${vault_pki_secret_backend_role.test.backend}/roles/user-ids"
+  ignore_absent_fields = true
+  data_json            = jsonencode({
+    allowed_domains  = ["test.my.domain"]
+    allow_subdomains = true
+    allowed_user_ids = ["alice", "bob"]
+    max_ttl          = "3600"
+  })
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = "user-ids"
+  common_name = "cert.test.my.domain"
+  user_ids    = ["alice", "bob"]
+  ttl         = "720h"
+
+  depends_on = [vault_generic_endpoint.user_ids]
+}
+`
+}
+
 func TestPkiSecretBackendCert_renew(t *testing.T) {
 	path := "pki-root-" + strconv.Itoa(acctest.RandInt())
 
@@ -203,6 +239,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 		resource.TestCheckResourceAttr(resourceName, "ttl", "1h"),
 		resource.TestCheckResourceAttr(resourceName, "min_seconds_remaining", "3595"),
 		resource.TestCheckResourceAttr(resourceName, "revoke", "false"),
+		resource.TestCheckResourceAttr(resourceName, "renew_pending", "false"),
 		resource.TestCheckResourceAttrSet(resourceName, "expiration"),
 		resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
 	}
@@ -354,6 +391,40 @@ func testCapturePKICert(resourceName string, store *testPKICertStore) resource.T
 	}
 }
 
+// testPKICertUserIDs checks that the issued certificate carries the expected
+// userID (OID 0.9.2342.19200300.100.1.1) values in its Subject.
+func testPKICertUserIDs(resourceName string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testGetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		p, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate"]))
+		if p == nil {
+			return fmt.Errorf("unable to decode certificate in state")
+		}
+		cert, err := x509.ParseCertificate(p.Bytes)
+		if err != nil {
+			return err
+		}
+
+		oidUserID := asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+		var actual []string
+		for _, name := range cert.Subject.Names {
+			if name.Type.Equal(oidUserID) {
+				actual = append(actual, fmt.Sprint(name.Value))
+			}
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			return fmt.Errorf("expected certificate user IDs %v, got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testPKICertRevocation(path string, store *testPKICertStore) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if store.cert == "" {
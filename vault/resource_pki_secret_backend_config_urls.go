@@ -58,6 +58,12 @@ func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 				Description: "Specifies the URL values for the OCSP Servers field.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"enable_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies that the AIA URL values should be templated, e.g. with {{issuer_id}}.",
+			},
 		},
 	}
 }
@@ -80,6 +86,11 @@ func pkiSecretBackendConfigUrlsCreateUpdate(d *schema.ResourceData, meta interfa
 		"ocsp_servers":            d.Get("ocsp_servers"),
 	}
 
+	// only send enable_templating when it is in use, older Vault versions do not support it.
+	if d.Get("enable_templating").(bool) || d.HasChange("enable_templating") {
+		data["enable_templating"] = d.Get("enable_templating").(bool)
+	}
+
 	log.Printf("[DEBUG] %s URL config on PKI secret backend %q", action, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -126,6 +137,12 @@ func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if v, ok := config.Data["enable_templating"]; ok {
+		if err := d.Set("enable_templating", v); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -72,6 +72,19 @@ func TestPkiSecretBackendConfigUrls_basic(t *testing.T) {
 					getChecks(issuingCertificates+"/new", crlDistributionPoints+"/new", ocspServers+"/new")...,
 				),
 			},
+			{
+				Config: testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath, issuingCertificates),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_templating", "true"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.0", issuingCertificates+"/{{issuer_id}}/der"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -155,3 +168,17 @@ resource "vault_pki_secret_backend_config_urls" "test" {
 		testPkiSecretBackendCertConfigUrlsMountConfig(rootPath),
 		issuingCertificates, crlDistributionPoints, ocspServers)
 }
+
+func testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath string, issuingCertificates string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_pki_secret_backend_config_urls" "test" {
+  backend              = vault_mount.test-root.path
+  issuing_certificates = ["%s/{{issuer_id}}/der"]
+  enable_templating    = true
+}
+`,
+		testPkiSecretBackendCertConfigUrlsMountConfig(rootPath),
+		issuingCertificates)
+}
@@ -7,8 +7,22 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+// pkiSecretBackendCrlConfigFields are the fields that are written to and
+// read from the CRL config endpoint.
+var pkiSecretBackendCrlConfigFields = []string{
+	"expiry",
+	"disable",
+	"ocsp_disable",
+	"auto_rebuild",
+	"auto_rebuild_grace_period",
+	"enable_delta",
+	"delta_rebuild_interval",
+}
+
 func pkiSecretBackendCrlConfigResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendCrlConfigCreate,
@@ -37,6 +51,33 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disables or enables the OCSP responder in Vault. Requires Vault 1.12+.",
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables periodic rebuilding of the CRL upon expiry. Requires Vault 1.12+.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Grace period before CRL expiry to attempt rebuild of CRL. Requires Vault 1.12+.",
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables building of delta CRLs with up-to-date revocation information. Requires Vault 1.12+.",
+			},
+			"delta_rebuild_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval to check for new revocations on, to regenerate the delta CRL. Requires Vault 1.12+.",
+			},
 		},
 	}
 }
@@ -47,13 +88,7 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	backend := d.Get("backend").(string)
 	path := pkiSecretBackendCrlConfigPath(backend)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -70,10 +105,15 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	backend := pkiSecretBackendCrlConfigPath(path)
+	backend := strings.TrimSuffix(path, "/config/crl")
 
 	log.Printf("[DEBUG] Reading CRL config from PKI secret backend %q", backend)
 	config, err := client.Logical().Read(path)
+	if err != nil && util.Is404(err) {
+		log.Printf("[WARN] CRL config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
 		log.Printf("[WARN] Removing path %q its ID is invalid", path)
@@ -86,8 +126,16 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	d.Set("expiry", config.Data["expiry"])
-	d.Set("disable", config.Data["disable"])
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		// older versions of Vault do not return the newer fields, e.g. the delta CRL settings
+		v, ok := config.Data[k]
+		if !ok {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting state key %q for CRL config on PKI secret backend %q: %w", k, backend, err)
+		}
+	}
 
 	return nil
 }
@@ -96,15 +144,9 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	backend := pkiSecretBackendCrlConfigPath(path)
+	backend := strings.TrimSuffix(path, "/config/crl")
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -121,6 +163,17 @@ func pkiSecretBackendCrlConfigDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func pkiSecretBackendCrlConfigRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
 func pkiSecretBackendCrlConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config/crl"
 }
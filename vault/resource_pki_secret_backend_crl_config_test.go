@@ -29,6 +29,18 @@ func TestPkiSecretBackendCrlConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "true"),
 				),
 			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_delta(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "expiry", "48h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "ocsp_disable", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "auto_rebuild", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "auto_rebuild_grace_period", "24h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "enable_delta", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "delta_rebuild_interval", "18m"),
+				),
+			},
 		},
 	})
 }
@@ -92,3 +104,43 @@ resource "vault_pki_secret_backend_crl_config" "test" {
 
 `, rootPath)
 }
+
+func testPkiSecretBackendCrlConfigConfig_delta(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+  description = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test-ca" {
+	backend    = vault_mount.test-root.path
+
+	type                 = "internal"
+	common_name          = "test-ca.example.com"
+	ttl                  = "8640000"
+	format               = "pem"
+	private_key_format   = "der"
+	key_type             = "rsa"
+	key_bits             = 4096
+	ou                   = "Test OU"
+	organization         = "ACME Ltd"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  depends_on = ["vault_pki_secret_backend_root_cert.test-ca"]
+
+  backend = vault_mount.test-root.path
+
+  expiry                    = "48h"
+  disable                   = false
+  ocsp_disable              = true
+  auto_rebuild              = true
+  auto_rebuild_grace_period = "24h"
+  enable_delta              = true
+  delta_rebuild_interval    = "18m"
+}
+`, rootPath)
+}
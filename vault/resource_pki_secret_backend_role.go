@@ -15,6 +15,21 @@ import (
 var (
 	pkiSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	pkiSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+
+	// pkiSecretBackendRoleKeyUsages are the key usages accepted by Vault,
+	// i.e. the x509.KeyUsage names without their "KeyUsage" prefix. Vault
+	// matches them case-insensitively.
+	pkiSecretBackendRoleKeyUsages = []string{
+		"DigitalSignature",
+		"ContentCommitment",
+		"KeyEncipherment",
+		"DataEncipherment",
+		"KeyAgreement",
+		"CertSign",
+		"CRLSign",
+		"EncipherOnly",
+		"DecipherOnly",
+	}
 )
 
 func pkiSecretBackendRoleResource() *schema.Resource {
@@ -185,7 +200,8 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Computed:    true,
 				Description: "Specify the allowed key usage constraint on issued certificates.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pkiSecretBackendRoleKeyUsages, true),
 				},
 			},
 			"ext_key_usage": {
@@ -197,6 +213,14 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"ext_key_usage_oids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of extended key usage OIDs to add to issued certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"use_csr_common_name": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -314,6 +338,16 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"cn_validations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of validations to run against the Common Name field.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "hostname", "disabled"}, false),
+				},
+			},
 		},
 	}
 }
@@ -350,6 +384,12 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
+	iExtKeyUsageOIDs := d.Get("ext_key_usage_oids").([]interface{})
+	extKeyUsageOIDs := make([]string, 0, len(iExtKeyUsageOIDs))
+	for _, iOID := range iExtKeyUsageOIDs {
+		extKeyUsageOIDs = append(extKeyUsageOIDs, iOID.(string))
+	}
+
 	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
 	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
 	for _, iIdentifier := range iPolicyIdentifiers {
@@ -362,6 +402,12 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		allowedSerialNumbers = append(allowedSerialNumbers, iSerialNumber.(string))
 	}
 
+	iCNValidations := d.Get("cn_validations").([]interface{})
+	cnValidations := make([]string, 0, len(iCNValidations))
+	for _, iValidation := range iCNValidations {
+		cnValidations = append(cnValidations, iValidation.(string))
+	}
+
 	data := map[string]interface{}{
 		"ttl":                                d.Get("ttl"),
 		"max_ttl":                            d.Get("max_ttl"),
@@ -409,6 +455,10 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
+	if len(extKeyUsageOIDs) > 0 {
+		data["ext_key_usage_oids"] = extKeyUsageOIDs
+	}
+
 	if len(policyIdentifiers) > 0 {
 		data["policy_identifiers"] = policyIdentifiers
 	}
@@ -417,6 +467,10 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
+	if len(cnValidations) > 0 {
+		data["cn_validations"] = cnValidations
+	}
+
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -481,6 +535,13 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
+	// ext_key_usage_oids is not returned by older versions of Vault.
+	iExtKeyUsageOIDs, _ := secret.Data["ext_key_usage_oids"].([]interface{})
+	extKeyUsageOIDs := make([]string, 0, len(iExtKeyUsageOIDs))
+	for _, iOID := range iExtKeyUsageOIDs {
+		extKeyUsageOIDs = append(extKeyUsageOIDs, iOID.(string))
+	}
+
 	iPolicyIdentifiers := secret.Data["policy_identifiers"].([]interface{})
 	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
 	for _, iIdentifier := range iPolicyIdentifiers {
@@ -495,6 +556,13 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		allowedSerialNumbers = append(allowedSerialNumbers, iSerialNumber.(string))
 	}
 
+	// cn_validations is not returned by older versions of Vault.
+	iCNValidations, _ := secret.Data["cn_validations"].([]interface{})
+	cnValidations := make([]string, 0, len(iCNValidations))
+	for _, iValidation := range iCNValidations {
+		cnValidations = append(cnValidations, iValidation.(string))
+	}
+
 	d.Set("backend", backend)
 	d.Set("name", name)
 	d.Set("ttl", secret.Data["ttl"])
@@ -518,6 +586,7 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_bits", keyBits)
 	d.Set("key_usage", keyUsage)
 	d.Set("ext_key_usage", extKeyUsage)
+	d.Set("ext_key_usage_oids", extKeyUsageOIDs)
 	d.Set("use_csr_common_name", secret.Data["use_csr_common_name"])
 	d.Set("use_csr_sans", secret.Data["use_csr_sans"])
 	d.Set("ou", secret.Data["ou"])
@@ -534,6 +603,7 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("basic_constraints_valid_for_non_ca", secret.Data["basic_constraints_valid_for_non_ca"])
 	d.Set("not_before_duration", notBeforeDuration)
 	d.Set("allowed_serial_numbers", allowedSerialNumbers)
+	d.Set("cn_validations", cnValidations)
 
 	return nil
 }
@@ -562,6 +632,12 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
+	iExtKeyUsageOIDs := d.Get("ext_key_usage_oids").([]interface{})
+	extKeyUsageOIDs := make([]string, 0, len(iExtKeyUsageOIDs))
+	for _, iOID := range iExtKeyUsageOIDs {
+		extKeyUsageOIDs = append(extKeyUsageOIDs, iOID.(string))
+	}
+
 	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
 	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
 	for _, iIdentifier := range iPolicyIdentifiers {
@@ -574,6 +650,12 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		allowedSerialNumbers = append(allowedSerialNumbers, iSerialNumber.(string))
 	}
 
+	iCNValidations := d.Get("cn_validations").([]interface{})
+	cnValidations := make([]string, 0, len(iCNValidations))
+	for _, iValidation := range iCNValidations {
+		cnValidations = append(cnValidations, iValidation.(string))
+	}
+
 	data := map[string]interface{}{
 		"ttl":                                d.Get("ttl"),
 		"max_ttl":                            d.Get("max_ttl"),
@@ -621,6 +703,10 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
+	if len(extKeyUsageOIDs) > 0 {
+		data["ext_key_usage_oids"] = extKeyUsageOIDs
+	}
+
 	if len(policyIdentifiers) > 0 {
 		data["policy_identifiers"] = policyIdentifiers
 	}
@@ -629,6 +715,10 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
+	if len(cnValidations) > 0 {
+		data["cn_validations"] = cnValidations
+	}
+
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
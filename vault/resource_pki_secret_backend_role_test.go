@@ -44,6 +44,8 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 		resource.TestCheckResourceAttr(resourceName, "key_usage.1", "KeyAgreement"),
 		resource.TestCheckResourceAttr(resourceName, "key_usage.2", "KeyEncipherment"),
 		resource.TestCheckResourceAttr(resourceName, "ext_key_usage.#", "0"),
+		resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.0", "1.3.6.1.5.5.7.3.1"),
 		resource.TestCheckResourceAttr(resourceName, "use_csr_common_name", "true"),
 		resource.TestCheckResourceAttr(resourceName, "use_csr_sans", "true"),
 		resource.TestCheckResourceAttr(resourceName, "ou.0", "test"),
@@ -124,6 +126,8 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "key_usage.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "key_usage.0", "DigitalSignature"),
 					resource.TestCheckResourceAttr(resourceName, "ext_key_usage.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.0", "hostname"),
 					resource.TestCheckResourceAttr(resourceName, "use_csr_common_name", "true"),
 					resource.TestCheckResourceAttr(resourceName, "use_csr_sans", "true"),
 					resource.TestCheckResourceAttr(resourceName, "ou.0", "test"),
@@ -176,6 +180,7 @@ resource "vault_pki_secret_backend_role" "test" {
   key_type                           = "rsa"
   key_bits                           = 2048
   ext_key_usage                      = []
+  ext_key_usage_oids                 = ["1.3.6.1.5.5.7.3.1"]
   use_csr_common_name                = true
   use_csr_sans                       = true
   ou                                 = ["test"]
@@ -244,6 +249,7 @@ resource "vault_pki_secret_backend_role" "test" {
   basic_constraints_valid_for_non_ca = false
   not_before_duration = "45m"
   allowed_serial_numbers = ["*"]
+  cn_validations = ["hostname"]
 }`, path, name)
 }
 
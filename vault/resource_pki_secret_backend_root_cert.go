@@ -35,6 +35,10 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := pkiSecretBackendRootCertValidateKeyRef(d); err != nil {
+				return err
+			}
+
 			key := "serial"
 			o, _ := d.GetChange(key)
 			// skip on new resource
@@ -74,9 +78,15 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of root to create. Must be one of \"exported\", \"internal\" or \"existing\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "existing"}, false),
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to an existing key to use when type is \"existing\"; either a key ID or key name.",
+				ForceNew:    true,
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -242,15 +252,53 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Computed:    true,
 				Description: "The certificate's serial number, hex formatted.",
 			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain as a list of format specific certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated issuer.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key used by the generated issuer.",
+			},
 		},
 	}
 }
 
+// pkiSecretBackendRootCertValidateKeyRef ensures that key_ref is set if, and
+// only if, type is "existing".
+func pkiSecretBackendRootCertValidateKeyRef(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("key_ref") {
+		return nil
+	}
+
+	rootType := d.Get("type").(string)
+	keyRef := d.Get("key_ref").(string)
+	if rootType == "existing" && keyRef == "" {
+		return fmt.Errorf("key_ref is required when type is %q", rootType)
+	}
+	if rootType != "existing" && keyRef != "" {
+		return fmt.Errorf("key_ref can only be set when type is %q", "existing")
+	}
+
+	return nil
+}
+
 func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	backend := d.Get("backend").(string)
 	rootType := d.Get("type").(string)
+	keyRef := d.Get("key_ref").(string)
 
 	path := pkiSecretBackendIntermediateSetSignedReadPath(backend, rootType)
 
@@ -322,6 +370,13 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 		data["permitted_dns_domains"] = strings.Join(permittedDNSDomains, ",")
 	}
 
+	if rootType == "existing" {
+		// the key type and size are taken from the referenced key
+		delete(data, "key_type")
+		delete(data, "key_bits")
+		data["key_ref"] = keyRef
+	}
+
 	log.Printf("[DEBUG] Creating root cert on PKI secret backend %q", backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -333,12 +388,31 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 	d.Set("issuing_ca", resp.Data["issuing_ca"])
 	d.Set("serial", resp.Data["serial_number"])
 	d.Set("serial_number", resp.Data["serial_number"])
+	d.Set("issuer_id", resp.Data["issuer_id"])
+	d.Set("key_id", resp.Data["key_id"])
+
+	d.Set("ca_chain", pkiSecretBackendRootCertCAChain(resp))
 
 	d.SetId(path)
 
 	return nil
 }
 
+// pkiSecretBackendRootCertCAChain returns the CA chain from the generation
+// response, falling back to the self-signed root itself when Vault does not
+// return one.
+func pkiSecretBackendRootCertCAChain(resp *api.Secret) []interface{} {
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok && len(v) > 0 {
+		return v
+	}
+
+	if v, ok := resp.Data["issuing_ca"].(string); ok && v != "" {
+		return []interface{}{v}
+	}
+
+	return nil
+}
+
 func getCACertificate(client *api.Client, mount string) (*x509.Certificate, error) {
 	path := fmt.Sprintf("/v1/%s/ca/pem", mount)
 	req := client.NewRequest(http.MethodGet, path)
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -38,6 +39,8 @@ func TestPkiSecretBackendRootCertificate_basic(t *testing.T) {
 		resource.TestCheckResourceAttr(resourceName, "province", "test"),
 		resource.TestCheckResourceAttrSet(resourceName, "serial"),
 		resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+		resource.TestCheckResourceAttr(resourceName, "ca_chain.#", "1"),
+		resource.TestCheckResourceAttrPair(resourceName, "ca_chain.0", resourceName, "issuing_ca"),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -156,6 +159,104 @@ resource "vault_pki_secret_backend_root_cert" "test" {
 	return config
 }
 
+func TestPkiSecretBackendRootCertificate_existing(t *testing.T) {
+	path := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resourceName := "vault_pki_secret_backend_root_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendRootCertificateConfig_existing(path, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`key_ref is required when type is "existing"`),
+			},
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_existing(path, "vault_generic_endpoint.key.write_data[\"key_id\"]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "existing"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "vault_generic_endpoint.key", "write_data.key_id"),
+					resource.TestCheckResourceAttr(resourceName, "ca_chain.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ca_chain.0", resourceName, "issuing_ca"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRootCertificateConfig_existing(path, keyRef string) string {
+	if keyRef == "" {
+		keyRef = `""`
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_generic_endpoint" "key" {
+  path                 = "${vault_mount.test.path}/keys/generate/internal"
+  disable_read         = true
+  disable_delete       = true
+  ignore_absent_fields = true
+  write_fields         = ["key_id"]
+  data_json = jsonencode({
+    key_type = "ec"
+    key_bits = 256
+  })
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "existing"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  key_ref     = %s
+}
+`, path, keyRef)
+}
+
+func Test_pkiSecretBackendRootCertCAChain(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *api.Secret
+		want []interface{}
+	}{
+		{
+			name: "ca-chain",
+			resp: &api.Secret{Data: map[string]interface{}{
+				"issuing_ca": "root",
+				"ca_chain":   []interface{}{"root", "other"},
+			}},
+			want: []interface{}{"root", "other"},
+		},
+		{
+			name: "issuing-ca-fallback",
+			resp: &api.Secret{Data: map[string]interface{}{
+				"issuing_ca": "root",
+			}},
+			want: []interface{}{"root"},
+		},
+		{
+			name: "empty",
+			resp: &api.Secret{Data: map[string]interface{}{}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkiSecretBackendRootCertCAChain(tt.resp); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pkiSecretBackendRootCertCAChain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_pkiSecretSerialNumberUpgradeV0(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -44,6 +44,12 @@ func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
 				Description: "The CSR.",
 				ForceNew:    true,
 			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reference to the issuer, by name or ID, used to sign the CSR. Defaults to the mount's default issuer. Requires Vault 1.11+.",
+				ForceNew:    true,
+			},
 			"common_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -222,7 +228,7 @@ func pkiSecretBackendRootSignIntermediateCreate(d *schema.ResourceData, meta int
 
 	backend := d.Get("backend").(string)
 
-	path := pkiSecretBackendRootSignIntermediateCreatePath(backend)
+	path := pkiSecretBackendRootSignIntermediateCreatePath(backend, d.Get("issuer_ref").(string))
 
 	commonName := d.Get("common_name").(string)
 
@@ -428,7 +434,10 @@ func pkiSecretBackendRootSignIntermediateDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
-func pkiSecretBackendRootSignIntermediateCreatePath(backend string) string {
+func pkiSecretBackendRootSignIntermediateCreatePath(backend, issuerRef string) string {
+	if issuerRef != "" {
+		return strings.Trim(backend, "/") + "/issuer/" + issuerRef + "/sign-intermediate"
+	}
 	return strings.Trim(backend, "/") + "/root/sign-intermediate"
 }
 
@@ -141,6 +141,30 @@ func TestPkiSecretBackendRootSignIntermediate_basic_default(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRootSignIntermediate_issuerRef(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	intermediatePath := "pki-intermediate-" + strconv.Itoa(acctest.RandInt())
+	format := "pem"
+	commonName := "SubOrg Intermediate CA"
+
+	resourceName := "vault_pki_secret_backend_root_sign_intermediate.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootSignIntermediateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootSignIntermediateConfig_issuerRef(rootPath, intermediatePath),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPKISecretRootSignIntermediate(resourceName, rootPath, commonName, format),
+					resource.TestCheckResourceAttrPair(resourceName, "issuer_ref",
+						"vault_pki_secret_backend_root_cert.test", "issuer_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestPkiSecretBackendRootSignIntermediate_basic_pem(t *testing.T) {
 	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
 	intermediatePath := "pki-intermediate-" + strconv.Itoa(acctest.RandInt())
@@ -385,6 +409,13 @@ resource "vault_pki_secret_backend_root_sign_intermediate" "test" {
 	return config + "}"
 }
 
+func testPkiSecretBackendRootSignIntermediateConfig_issuerRef(rootPath, path string) string {
+	config := testPkiSecretBackendRootSignIntermediateConfig_basic(rootPath, path, "", false)
+	return strings.TrimSuffix(config, "}") + `
+  issuer_ref = vault_pki_secret_backend_root_cert.test.issuer_id
+}`
+}
+
 func testPkiSecretBackendRootSignIntermediateConfig_multiple_inter(rootPath, prePath, path, format string) string {
 	config := fmt.Sprintf(`
 resource "vault_mount" "root" {
@@ -0,0 +1,196 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginWrite,
+		Update: pluginWrite,
+		Read:   pluginRead,
+		Delete: pluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of plugin; one of 'secret', 'auth' or 'database'.",
+				ValidateFunc: validation.StringInSlice([]string{"secret", "auth", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Command to execute the plugin, relative to the plugin_directory.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SHA256 sum of the plugin binary.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of additional args to pass to the plugin.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"env": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "List of additional environment variables to run the plugin with in KEY=VALUE form.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Semantic version of the plugin.",
+			},
+		},
+	}
+}
+
+func pluginWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	path := pluginCatalogPath(pluginType, name)
+
+	data := map[string]interface{}{
+		"command": d.Get("command").(string),
+		"sha256":  d.Get("sha256").(string),
+		"args":    d.Get("args").([]interface{}),
+		"env":     d.Get("env").([]interface{}),
+	}
+	if version != "" {
+		data["version"] = version
+	}
+
+	log.Printf("[DEBUG] Registering plugin %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error registering plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Registered plugin %q", path)
+
+	d.SetId(pluginID(pluginType, name, version))
+
+	return pluginRead(d, meta)
+}
+
+func pluginRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading plugin %q", path)
+	resp, err := client.Logical().ReadWithData(path, pluginVersionQuery(version))
+	if err != nil {
+		return fmt.Errorf("error reading plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read plugin %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("type", pluginType); err != nil {
+		return err
+	}
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("version", version); err != nil {
+		return err
+	}
+
+	// env is not returned by Vault.
+	for _, k := range []string{"command", "sha256", "args"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on plugin %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func pluginDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Deregistering plugin %q", path)
+	if _, err := client.Logical().DeleteWithData(path, pluginVersionQuery(version)); err != nil {
+		return fmt.Errorf("error deregistering plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deregistered plugin %q", path)
+
+	return nil
+}
+
+func pluginCatalogPath(pluginType, name string) string {
+	return fmt.Sprintf("sys/plugins/catalog/%s/%s", pluginType, name)
+}
+
+func pluginVersionQuery(version string) map[string][]string {
+	if version == "" {
+		return nil
+	}
+	return map[string][]string{
+		"version": {version},
+	}
+}
+
+// pluginID returns the resource ID for a plugin, in the form type/name or
+// type/name/version when a version is registered.
+func pluginID(pluginType, name, version string) string {
+	id := pluginType + "/" + name
+	if version != "" {
+		id += "/" + version
+	}
+	return id
+}
+
+func pluginParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 2:
+		return parts[0], parts[1], "", nil
+	case 3:
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("invalid plugin ID %q, expected type/name or type/name/version", id)
+}
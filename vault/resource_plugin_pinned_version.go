@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginPinnedVersionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginPinnedVersionWrite,
+		Update: pluginPinnedVersionWrite,
+		Read:   pluginPinnedVersionRead,
+		Delete: pluginPinnedVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of plugin; one of 'secret', 'auth' or 'database'.",
+				ValidateFunc: validation.StringInSlice([]string{"secret", "auth", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Semantic version of the plugin to pin.",
+			},
+		},
+	}
+}
+
+func pluginPinnedVersionWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	data := map[string]interface{}{
+		"version": d.Get("version").(string),
+	}
+
+	log.Printf("[DEBUG] Pinning plugin version %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error pinning plugin version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Pinned plugin version %q", path)
+
+	d.SetId(pluginType + "/" + name)
+
+	// Running plugin instances only pick up the pinned version once reloaded.
+	log.Printf("[DEBUG] Reloading plugin %q", name)
+	if _, err := client.Sys().ReloadPlugin(&api.ReloadPluginInput{Plugin: name}); err != nil {
+		return fmt.Errorf("error reloading plugin %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Reloaded plugin %q", name)
+
+	return pluginPinnedVersionRead(d, meta)
+}
+
+func pluginPinnedVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid plugin pinned version ID %q, expected type/name", d.Id())
+	}
+	pluginType, name := parts[0], parts[1]
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading plugin pinned version %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading plugin pinned version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read plugin pinned version %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Plugin pinned version %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("type", pluginType); err != nil {
+		return err
+	}
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("version", resp.Data["version"]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func pluginPinnedVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := pluginPinnedVersionPath(d.Get("type").(string), d.Get("name").(string))
+
+	log.Printf("[DEBUG] Removing plugin pinned version %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error removing plugin pinned version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed plugin pinned version %q", path)
+
+	return nil
+}
+
+func pluginPinnedVersionPath(pluginType, name string) string {
+	return fmt.Sprintf("sys/plugins/pins/%s/%s", pluginType, name)
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPluginPinnedVersion(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "VAULT_PLUGIN_COMMAND", "VAULT_PLUGIN_SHA256")
+	command, sha256 := values[0], values[1]
+	name := acctest.RandomWithPrefix("plugin")
+	resourceName := "vault_plugin_pinned_version.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginPinnedVersionConfig(name, command, sha256, "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "version", "v1.0.0"),
+				),
+			},
+			{
+				Config: testPluginPinnedVersionConfig(name, command, sha256, "v1.1.0"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "version", "v1.1.0"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPluginPinnedVersionConfig(name, command, sha256, pinned string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "v1_0" {
+  type    = "secret"
+  name    = "%[1]s"
+  command = "%[2]s"
+  sha256  = "%[3]s"
+  version = "v1.0.0"
+}
+
+resource "vault_plugin" "v1_1" {
+  type    = "secret"
+  name    = "%[1]s"
+  command = "%[2]s"
+  sha256  = "%[3]s"
+  version = "v1.1.0"
+}
+
+resource "vault_plugin_pinned_version" "test" {
+  type       = "secret"
+  name       = "%[1]s"
+  version    = "%[4]s"
+  depends_on = [vault_plugin.v1_0, vault_plugin.v1_1]
+}
+`, name, command, sha256, pinned)
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pluginReloadTargetFields = []string{"plugin", "mounts"}
+
+// pluginReloadResource reloads plugin backends on creation. Every argument
+// forces a new resource, so changing any of them triggers another reload.
+func pluginReloadResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginReloadCreate,
+		Read:   pluginReloadRead,
+		Delete: pluginReloadDelete,
+
+		Schema: map[string]*schema.Schema{
+			"plugin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the plugin to reload, as registered in the plugin catalog.",
+				ExactlyOneOf: pluginReloadTargetFields,
+			},
+			"mounts": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "List of mount paths of the plugin backends to reload.",
+				ExactlyOneOf: pluginReloadTargetFields,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Scope of the reload. Set to 'global' to reload the plugins on all nodes of the cluster.",
+				ValidateFunc: validation.StringInSlice([]string{"global"}, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger a reload.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"reload_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the reload, returned for global reloads.",
+			},
+		},
+	}
+}
+
+func pluginReloadCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	input := &api.ReloadPluginInput{
+		Plugin: d.Get("plugin").(string),
+		Scope:  d.Get("scope").(string),
+	}
+	for _, mount := range d.Get("mounts").([]interface{}) {
+		input.Mounts = append(input.Mounts, mount.(string))
+	}
+
+	log.Printf("[DEBUG] Reloading plugin backends")
+	reloadID, err := client.Sys().ReloadPlugin(input)
+	if err != nil {
+		return fmt.Errorf("error reloading plugin backends: %s", err)
+	}
+	log.Printf("[DEBUG] Reloaded plugin backends")
+
+	if err := d.Set("reload_id", reloadID); err != nil {
+		return err
+	}
+
+	if reloadID == "" {
+		reloadID = resource.UniqueId()
+	}
+	d.SetId(reloadID)
+
+	return nil
+}
+
+func pluginReloadRead(d *schema.ResourceData, meta interface{}) error {
+	// A reload has no state in Vault to read back.
+	return nil
+}
+
+func pluginReloadDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
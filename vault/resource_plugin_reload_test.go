@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPluginReload(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "VAULT_PLUGIN_COMMAND", "VAULT_PLUGIN_SHA256")
+	command, sha256 := values[0], values[1]
+	name := acctest.RandomWithPrefix("plugin")
+	resourceName := "vault_plugin_reload.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginReloadConfig(name, command, sha256, `plugin = vault_plugin.test.name`, "1"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "plugin", name),
+			},
+			{
+				Config: testPluginReloadConfig(name, command, sha256, `mounts = [vault_mount.test.path]`, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mounts.0", name),
+					resource.TestCheckResourceAttr(resourceName, "triggers.build", "2"),
+				),
+			},
+			{
+				Config: testPluginReloadConfig(name, command, sha256,
+					"plugin = vault_plugin.test.name\n  mounts = [vault_mount.test.path]", "3"),
+				ExpectError: regexp.MustCompile("only one of `mounts,plugin` can be specified"),
+			},
+		},
+	})
+}
+
+func testPluginReloadConfig(name, command, sha256, target, build string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type    = "secret"
+  name    = "%[1]s"
+  command = "%[2]s"
+  sha256  = "%[3]s"
+}
+
+resource "vault_mount" "test" {
+  path = "%[1]s"
+  type = vault_plugin.test.name
+}
+
+resource "vault_plugin_reload" "test" {
+  %[4]s
+
+  triggers = {
+    build = "%[5]s"
+  }
+
+  depends_on = [vault_mount.test]
+}
+`, name, command, sha256, target, build)
+}
@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPluginParseID(t *testing.T) {
+	tests := []struct {
+		id                          string
+		wantType, wantName, wantVer string
+		wantErr                     bool
+	}{
+		{id: "secret/my-plugin", wantType: "secret", wantName: "my-plugin"},
+		{id: "auth/my-plugin/v1.0.0", wantType: "auth", wantName: "my-plugin", wantVer: "v1.0.0"},
+		{id: "my-plugin", wantErr: true},
+		{id: "database/a/b/c", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			pluginType, name, version, err := pluginParseID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pluginParseID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if pluginType != tt.wantType || name != tt.wantName || version != tt.wantVer {
+				t.Errorf("pluginParseID() got = (%q, %q, %q), want (%q, %q, %q)",
+					pluginType, name, version, tt.wantType, tt.wantName, tt.wantVer)
+			}
+			if !tt.wantErr {
+				if id := pluginID(pluginType, name, version); id != tt.id {
+					t.Errorf("pluginID() got = %q, want %q", id, tt.id)
+				}
+			}
+		})
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "VAULT_PLUGIN_COMMAND", "VAULT_PLUGIN_SHA256")
+	command, sha256 := values[0], values[1]
+	name := acctest.RandomWithPrefix("plugin")
+	resourceName := "vault_plugin.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testPluginCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginConfig(name, command, sha256, `["--log-level=info"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "command", command),
+					resource.TestCheckResourceAttr(resourceName, "sha256", sha256),
+					resource.TestCheckResourceAttr(resourceName, "args.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "args.0", "--log-level=info"),
+				),
+			},
+			{
+				Config: testPluginConfig(name, command, sha256, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "args.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env"},
+			},
+		},
+	})
+}
+
+func testPluginCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin" {
+			continue
+		}
+		pluginType, name, version, err := pluginParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Logical().ReadWithData(pluginCatalogPath(pluginType, name), pluginVersionQuery(version))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPluginConfig(name, command, sha256, args string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type    = "secret"
+  name    = "%s"
+  command = "%s"
+  sha256  = "%s"
+  args    = %s
+  env     = ["FOO=bar"]
+}
+`, name, command, sha256, args)
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/vault/api"
 )
 
 func quotaLeaseCountPath(name string) string {
@@ -42,14 +41,36 @@ func quotaLeaseCountResource() *schema.Resource {
 				Required:     true,
 				ForceNew:     false,
 				Description:  "The maximum number of leases to be allowed by the quota rule. The max_leases must be positive.",
-				ValidateFunc: validation.IntAtLeast(0),
+				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			fieldNamespace: namespaceSchema(),
 		},
 	}
 }
 
+func quotaLeaseCountRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["max_leases"] = d.Get("max_leases").(int)
+
+	// always send role on change so that removing it clears it in Vault
+	if d.HasChange("role") {
+		data["role"] = d.Get("role").(string)
+	}
+
+	return data
+}
+
 func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Get("name").(string)
 	path := quotaLeaseCountPath(name)
@@ -57,11 +78,9 @@ func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating Resource Lease Count Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
+	data := quotaLeaseCountRequestData(d)
 
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error creating Resource Lease Count Quota %s: %s", name, err)
@@ -72,7 +91,10 @@ func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaLeaseCountPath(name)
@@ -89,7 +111,7 @@ func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "max_leases"} {
+	for _, k := range []string{"path", "max_leases", "role"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -102,18 +124,19 @@ func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaLeaseCountPath(name)
 
 	log.Printf("[DEBUG] Updating Resource Lease Count Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
+	data := quotaLeaseCountRequestData(d)
 
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error updating Resource Lease Count Quota %s: %s", name, err)
@@ -124,13 +147,16 @@ func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaLeaseCountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaLeaseCountPath(name)
 
 	log.Printf("[DEBUG] Deleting Resource Lease Count Quota %s", name)
-	_, err := client.Logical().Delete(path)
+	_, err = client.Logical().Delete(path)
 	if err != nil {
 		return fmt.Errorf("Error deleting Resource Lease Count Quota %s", name)
 	}
@@ -140,7 +166,10 @@ func quotaLeaseCountDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaLeaseCountExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return true, err
+	}
 
 	name := d.Id()
 	path := quotaLeaseCountPath(name)
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -51,6 +52,43 @@ func TestQuotaLeaseCount(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "max_leases", newLeaseCount),
 				),
 			},
+			{
+				ResourceName:      "vault_quota_lease_count.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testQuotaLeaseCount_Config(name, "", "0"),
+				ExpectError: regexp.MustCompile(`expected max_leases to be at least \(1\)`),
+			},
+		},
+	})
+}
+
+func TestQuotaLeaseCount_role(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	backend := acctest.RandomWithPrefix("approle")
+	leaseCount := randomQuotaLeaseString()
+	resourceName := "vault_quota_lease_count.foobar"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testQuotaLeaseCountCheckDestroy([]string{name}),
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaLeaseCount_ConfigRole(name, backend, leaseCount, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr(resourceName, "role", "test"),
+				),
+			},
+			{
+				Config: testQuotaLeaseCount_ConfigRole(name, backend, leaseCount, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr(resourceName, "role", ""),
+				),
+			},
 		},
 	})
 }
@@ -84,3 +122,29 @@ resource "vault_quota_lease_count" "foobar" {
 }
 `, name, path, max_leases)
 }
+
+func testQuotaLeaseCount_ConfigRole(name, backend, maxLeases string, withRole bool) string {
+	role := ""
+	if withRole {
+		role = "role = vault_approle_auth_backend_role.test.role_name"
+	}
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "test" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "test"
+}
+
+resource "vault_quota_lease_count" "foobar" {
+  name       = "%s"
+  path       = "auth/${vault_auth_backend.approle.path}/"
+  max_leases = %s
+  %s
+}
+`, backend, name, maxLeases, role)
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/vault/api"
 )
 
 func quotaRateLimitPath(name string) string {
@@ -43,12 +42,53 @@ func quotaRateLimitResource() *schema.Resource {
 				Description:  "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
 				ValidateFunc: validation.FloatAtLeast(0.0),
 			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The duration in seconds to enforce rate limiting for.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"block_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "If set, when a client reaches a rate limit threshold, the client will be prohibited from any further requests until after the 'block_interval' in seconds has elapsed.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			fieldNamespace: namespaceSchema(),
 		},
 	}
 }
 
+func quotaRateLimitRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["rate"] = d.Get("rate").(float64)
+
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(int)
+	}
+
+	data["block_interval"] = d.Get("block_interval").(int)
+
+	// always send role on change so that removing it clears it in Vault
+	if d.HasChange("role") {
+		data["role"] = d.Get("role").(string)
+	}
+
+	return data
+}
+
 func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Get("name").(string)
 	path := quotaRateLimitPath(name)
@@ -56,11 +96,9 @@ func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating Resource Rate Limit Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
+	data := quotaRateLimitRequestData(d)
 
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error creating Resource Rate Limit Quota %s: %s", name, err)
@@ -71,7 +109,10 @@ func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaRateLimitPath(name)
@@ -88,7 +129,7 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "rate"} {
+	for _, k := range []string{"path", "rate", "interval", "block_interval", "role"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -101,18 +142,19 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaRateLimitPath(name)
 
 	log.Printf("[DEBUG] Updating Resource Rate Limit Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
+	data := quotaRateLimitRequestData(d)
 
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error updating Resource Rate Limit Quota %s: %s", name, err)
@@ -123,13 +165,16 @@ func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 	path := quotaRateLimitPath(name)
 
 	log.Printf("[DEBUG] Deleting Resource Rate Limit Quota %s", name)
-	_, err := client.Logical().Delete(path)
+	_, err = client.Logical().Delete(path)
 	if err != nil {
 		return fmt.Errorf("Error deleting Resource Rate Limit Quota %s", name)
 	}
@@ -139,7 +184,10 @@ func quotaRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func quotaRateLimitExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*api.Client)
+	client, err := getClient(d, meta)
+	if err != nil {
+		return true, err
+	}
 
 	name := d.Id()
 	path := quotaRateLimitPath(name)
@@ -53,6 +53,51 @@ func TestQuotaRateLimit(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "name", name),
 					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "path", "sys/"),
 					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "interval", "1"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "block_interval", "0"),
+				),
+			},
+			{
+				Config: testQuotaRateLimit_ConfigIntervals(name, "sys/", newRateLimit, 30, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "name", name),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "path", "sys/"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "interval", "30"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "block_interval", "60"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_rate_limit.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestQuotaRateLimit_role(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	backend := acctest.RandomWithPrefix("approle")
+	rateLimit := randomQuotaRateString()
+	resourceName := "vault_quota_rate_limit.foobar"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testQuotaRateLimitCheckDestroy([]string{name}),
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaRateLimit_ConfigRole(name, backend, rateLimit, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr(resourceName, "role", "test"),
+				),
+			},
+			{
+				Config: testQuotaRateLimit_ConfigRole(name, backend, rateLimit, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr(resourceName, "role", ""),
 				),
 			},
 		},
@@ -88,3 +133,41 @@ resource "vault_quota_rate_limit" "foobar" {
 }
 `, name, path, rate)
 }
+
+func testQuotaRateLimit_ConfigIntervals(name, path, rate string, interval, blockInterval int) string {
+	return fmt.Sprintf(`
+resource "vault_quota_rate_limit" "foobar" {
+  name           = "%s"
+  path           = "%s"
+  rate           = %s
+  interval       = %d
+  block_interval = %d
+}
+`, name, path, rate, interval, blockInterval)
+}
+
+func testQuotaRateLimit_ConfigRole(name, backend, rate string, withRole bool) string {
+	role := ""
+	if withRole {
+		role = "role = vault_approle_auth_backend_role.test.role_name"
+	}
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "test" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "test"
+}
+
+resource "vault_quota_rate_limit" "foobar" {
+  name = "%s"
+  path = "auth/${vault_auth_backend.approle.path}/"
+  rate = %s
+  %s
+}
+`, backend, name, rate, role)
+}
@@ -75,11 +75,13 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 		},
 		"aws_secret_access_key": {
 			Type:        schema.TypeString,
+			Sensitive:   true,
 			Description: "AWS secret access key.",
 			Optional:    true,
 		},
 		"aws_session_token": {
 			Type:        schema.TypeString,
+			Sensitive:   true,
 			Description: "AWS session token.",
 			Optional:    true,
 		},
@@ -120,6 +122,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 		},
 		"google_service_account_key": {
 			Type:        schema.TypeString,
+			Sensitive:   true,
 			Description: "Google service account key in JSON format.",
 			Optional:    true,
 		},
@@ -145,6 +148,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 		},
 		"azure_account_key": {
 			Type:        schema.TypeString,
+			Sensitive:   true,
 			Description: "Azure account key.",
 			Optional:    true,
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 	"golang.org/x/crypto/ssh"
 )
@@ -162,6 +163,16 @@ func sshSecretBackendRoleResource() *schema.Resource {
 			Type:     schema.TypeString,
 			Optional: true,
 			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"default", ssh.SigAlgoRSA, ssh.SigAlgoRSASHA2256, ssh.SigAlgoRSASHA2512,
+			}, false),
+			Description: "The signing algorithm to use for issued certificates.",
+		},
+		"not_before_duration": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the duration by which to backdate the ValidAfter property of issued certificates.",
 		},
 		"max_ttl": {
 			Type:     schema.TypeString,
@@ -250,6 +261,10 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["algorithm_signer"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("not_before_duration"); ok {
+		data["not_before_duration"] = v.(string)
+	}
+
 	if v, ok := d.GetOk("max_ttl"); ok {
 		data["max_ttl"] = v.(string)
 	}
@@ -365,6 +380,13 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// not_before_duration is not returned by older Vault versions
+	if v, ok := role.Data["not_before_duration"]; ok {
+		if err := d.Set("not_before_duration", v); err != nil {
+			return err
+		}
+	}
+
 	if err := setSSHRoleKeyConfig(d, role); err != nil {
 		return err
 	}
@@ -43,6 +43,7 @@ func TestAccSSHSecretBackendRole(t *testing.T) {
 		resource.TestCheckResourceAttr(resourceName, "key_type", "ca"),
 		resource.TestCheckResourceAttr(resourceName, "allowed_user_key_config_lengths.%", "0"),
 		resource.TestCheckResourceAttr(resourceName, "algorithm_signer", "default"),
+		resource.TestCheckResourceAttr(resourceName, "not_before_duration", "30"),
 		resource.TestCheckResourceAttr(resourceName, "max_ttl", "0"),
 		resource.TestCheckResourceAttr(resourceName, "ttl", "0"),
 	)
@@ -64,6 +65,7 @@ func TestAccSSHSecretBackendRole(t *testing.T) {
 		resource.TestCheckResourceAttr(resourceName, "key_id_format", "{{role_name}}-test"),
 		resource.TestCheckResourceAttr(resourceName, "key_type", "ca"),
 		resource.TestCheckResourceAttr(resourceName, "algorithm_signer", "rsa-sha2-256"),
+		resource.TestCheckResourceAttr(resourceName, "not_before_duration", "100"),
 		resource.TestCheckResourceAttr(resourceName, "max_ttl", "86400"),
 		resource.TestCheckResourceAttr(resourceName, "ttl", "43200"),
 	)
@@ -221,6 +223,7 @@ resource "vault_ssh_secret_backend_role" "test_role" {
   key_id_format            = "{{role_name}}-test"
   key_type                 = "ca"
   algorithm_signer         = "rsa-sha2-256"
+  not_before_duration      = "100"
   max_ttl                  = "86400"
   ttl                      = "43200"
 `, name))
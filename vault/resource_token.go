@@ -1,15 +1,23 @@
 package vault
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+const (
+	tokenTypeService = "service"
+	tokenTypeBatch   = "batch"
+)
+
 func tokenResource() *schema.Resource {
 	return &schema.Resource{
 		Create: tokenCreate,
@@ -61,6 +69,14 @@ func tokenResource() *schema.Resource {
 				Computed:    true,
 				Description: "Flag to allow the token to be renewed",
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      tokenTypeService,
+				ValidateFunc: validation.StringInSlice([]string{tokenTypeService, tokenTypeBatch}, false),
+				Description:  "The type of token to create, either 'service' or 'batch'. Batch tokens cannot be renewed.",
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Required:    false,
@@ -194,8 +210,14 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		createRequest.NumUses = v.(int)
 	}
 
+	tokenType := d.Get("type").(string)
+	createRequest.Type = tokenType
+
 	if v, ok := d.GetOk("renewable"); ok {
 		renewable := v.(bool)
+		if renewable && tokenType == tokenTypeBatch {
+			return fmt.Errorf("batch tokens cannot be renewable")
+		}
 		createRequest.Renewable = &renewable
 	}
 
@@ -253,14 +275,96 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("client_token", resp.Auth.ClientToken)
 	}
 
+	if tokenType == tokenTypeBatch {
+		return tokenBatchCreated(d, resp, wrapped)
+	}
+
 	d.SetId(accessor)
 
 	return tokenRead(d, meta)
 }
 
+// tokenBatchCreated sets the state for a newly created batch token.
+// Batch tokens have no accessor, so the resource ID is derived from the
+// token itself, and the lease information is taken from the create response
+// since batch tokens can neither be looked up by accessor nor renewed.
+func tokenBatchCreated(d *schema.ResourceData, resp *api.Secret, wrapped bool) error {
+	if wrapped {
+		d.SetId(tokenBatchID(resp.WrapInfo.Token))
+		d.Set("lease_duration", 0)
+	} else {
+		d.SetId(tokenBatchID(resp.Auth.ClientToken))
+		d.Set("lease_duration", resp.Auth.LeaseDuration)
+		d.Set("no_parent", resp.Auth.Orphan)
+	}
+
+	d.Set("lease_started", time.Now().Format(time.RFC3339))
+	d.Set("renewable", false)
+
+	log.Printf("[DEBUG] Created batch token %q", d.Id())
+
+	return nil
+}
+
+func tokenBatchID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return tokenTypeBatch + "-" + hex.EncodeToString(sum[:])
+}
+
+// tokenIsBatch reports whether the resource is a batch token, based on the
+// resource ID set by tokenBatchCreated. The type in state can't be relied on,
+// since it is only known once the token has been read back.
+func tokenIsBatch(d *schema.ResourceData) bool {
+	return strings.HasPrefix(d.Id(), tokenTypeBatch+"-")
+}
+
+func tokenBatchRead(d *schema.ResourceData, client *api.Client) error {
+	// Batch tokens can't be looked up by accessor, but the token itself can
+	// be looked up when it isn't wrapped.
+	if token := d.Get("client_token").(string); token != "" {
+		log.Printf("[DEBUG] Reading batch token %q", d.Id())
+		resp, err := client.Auth().Token().Lookup(token)
+		if err != nil {
+			log.Printf("[WARN] Batch token %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Read batch token %q", d.Id())
+
+		d.Set("type", resp.Data["type"])
+		return nil
+	}
+
+	d.Set("type", tokenTypeBatch)
+
+	startedStr := d.Get("lease_started").(string)
+	leaseDuration := d.Get("lease_duration").(int)
+	if startedStr == "" || leaseDuration <= 0 {
+		return nil
+	}
+
+	started, err := time.Parse(time.RFC3339, startedStr)
+	if err != nil {
+		log.Printf("[DEBUG] lease_started %q for batch token %q is an invalid value, removing: %s", startedStr, d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if started.Add(time.Second * time.Duration(leaseDuration)).Before(time.Now()) {
+		log.Printf("[DEBUG] batch token %q has expired, removing from state", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
 func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
+	if tokenIsBatch(d) {
+		return tokenBatchRead(d, client)
+	}
+
 	id := d.Get("client_token").(string)
 	accessor := d.Id()
 
@@ -285,6 +389,7 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("policies", policies)
+	d.Set("type", resp.Data["type"])
 	d.Set("no_parent", resp.Data["orphan"])
 	d.Set("renewable", resp.Data["renewable"])
 	d.Set("display_name", strings.TrimPrefix(resp.Data["display_name"].(string), "token-"))
@@ -354,6 +459,11 @@ func tokenDelete(d *schema.ResourceData, meta interface{}) error {
 
 	token := d.Id()
 
+	if tokenIsBatch(d) {
+		log.Printf("[DEBUG] Batch token %q cannot be revoked, it will expire at the end of its TTL", token)
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting token %q", token)
 	err := client.Auth().Token().RevokeAccessor(token)
 	if err != nil {
@@ -368,6 +478,11 @@ func tokenExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*api.Client)
 	accessor := d.Id()
 
+	if tokenIsBatch(d) {
+		// batch tokens cannot be looked up by accessor, expiry is handled by tokenRead.
+		return true, nil
+	}
+
 	log.Printf("[DEBUG] Checking if token accessor %q exists", accessor)
 	resp, err := client.Auth().Token().LookupAccessor(accessor)
 	if err != nil {
@@ -24,6 +24,8 @@ func tokenAuthBackendRoleTokenConfig() *addTokenFieldsConfig {
 		TokenTTLConflict:    []string{"token_period"},
 
 		TokenTypeDefault: "default-service",
+		// default-service and default-batch are only accepted by token store roles.
+		TokenTypeValues: []string{"service", "batch", "default", "default-service", "default-batch"},
 	}
 }
 
@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -51,6 +52,29 @@ func TestAccTokenAuthBackendRole(t *testing.T) {
 	})
 }
 
+func TestAccTokenAuthBackendRole_numUses(t *testing.T) {
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckTokenAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTokenAuthBackendRoleConfigNumUses(role, -1),
+				ExpectError: regexp.MustCompile(`expected token_num_uses to be at least \(0\), got -1`),
+			},
+			{
+				Config: testAccTokenAuthBackendRoleConfigNumUses(role, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_num_uses", "1"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "orphan", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 	role := acctest.RandomWithPrefix("test-role")
 	roleUpdated := acctest.RandomWithPrefix("test-role-updated")
@@ -294,6 +318,15 @@ resource "vault_token_auth_backend_role" "role" {
 }`, roleName)
 }
 
+func testAccTokenAuthBackendRoleConfigNumUses(roleName string, numUses int) string {
+	return fmt.Sprintf(`
+resource "vault_token_auth_backend_role" "role" {
+  role_name      = "%s"
+  orphan         = true
+  token_num_uses = %d
+}`, roleName, numUses)
+}
+
 func testAccTokenAuthBackendRoleConfigUpdate(role string) string {
 	return fmt.Sprintf(`
 resource "vault_token_auth_backend_role" "role" {
@@ -57,6 +57,7 @@ func TestResourceToken_import(t *testing.T) {
 			{
 				Config: testResourceTokenConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "type", "service"),
 					resource.TestCheckResourceAttr("vault_token.test", "policies.#", "1"),
 					resource.TestCheckResourceAttr("vault_token.test", "ttl", "60s"),
 					resource.TestCheckResourceAttrSet("vault_token.test", "lease_duration"),
@@ -75,6 +76,66 @@ func TestResourceToken_import(t *testing.T) {
 	})
 }
 
+func TestResourceToken_batch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_batch(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "type", "batch"),
+					resource.TestCheckResourceAttr("vault_token.test", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_token.test", "ttl", "60s"),
+					resource.TestCheckResourceAttr("vault_token.test", "renewable", "false"),
+					resource.TestCheckResourceAttr("vault_token.test", "lease_duration", "60"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "lease_started"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "client_token"),
+					testResourceTokenBatchLookup("vault_token.test"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceTokenBatchLookup(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Auth().Token().Lookup(rs.Primary.Attributes["client_token"])
+		if err != nil {
+			return fmt.Errorf("Token could not be found: %s", err)
+		}
+
+		if resp.Data["type"] != "batch" {
+			return fmt.Errorf("expected a batch token, got %q", resp.Data["type"])
+		}
+
+		return nil
+	}
+}
+
+func testResourceTokenConfig_batch() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	type = "batch"
+	policies = [ vault_policy.test.name ]
+	ttl = "60s"
+}`
+}
+
 func testResourceTokenConfig_basic() string {
 	return `
 resource "vault_policy" "test" {
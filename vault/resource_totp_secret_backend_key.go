@@ -0,0 +1,241 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var totpSecretBackendKeyIDRegex = regexp.MustCompile("^(.+)/keys/(.+)$")
+
+// totpSecretBackendKeyResource manages a TOTP key. Vault has no update
+// operation for TOTP keys, so every argument forces a new resource.
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the TOTP secret backend is mounted at.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key.",
+			},
+			"generate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether Vault generates the key, acting as a TOTP generator. If false, url or key must be set.",
+			},
+			"exported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether a generated key is returned as a barcode and url. Only used if generate is true.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      20,
+				Description:  "Size in bytes of the generated key. Only used if generate is true.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The TOTP key url string. Computed for generated and exported keys, otherwise the url of the key to import.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The root key used to generate a TOTP code. Only used if generate is false and url is not set.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the account associated with the key.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The length of time in seconds used to generate a counter for the TOTP code calculation.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The hashing algorithm used to generate the TOTP code; one of 'SHA1', 'SHA256' or 'SHA512'.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The number of digits in the generated TOTP code; one of 6 or 8.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				Description:  "The number of delay periods allowed when validating a TOTP code; one of 0 or 1.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"qr_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      200,
+				Description:  "The pixel size of the square QR code of a generated key. A value of 0 disables the barcode.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded PNG QR code of a generated and exported key.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := backend + "/keys/" + name
+
+	generate := d.Get("generate").(bool)
+	data := map[string]interface{}{
+		"generate": generate,
+		"skew":     d.Get("skew").(int),
+	}
+	if generate {
+		data["exported"] = d.Get("exported").(bool)
+		data["key_size"] = d.Get("key_size").(int)
+		data["qr_size"] = d.Get("qr_size").(int)
+	}
+	for _, k := range []string{"url", "key", "issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing TOTP key %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote TOTP key %q", path)
+
+	d.SetId(path)
+
+	// The barcode and url of generated keys are only returned on creation.
+	if resp != nil {
+		for _, k := range []string{"barcode", "url"} {
+			if v, ok := resp.Data[k]; ok {
+				if err := d.Set(k, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	res := totpSecretBackendKeyIDRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid TOTP key ID %q, expected <backend>/keys/<name>", path)
+	}
+
+	log.Printf("[DEBUG] Reading TOTP key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read TOTP key %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] TOTP key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", res[1]); err != nil {
+		return err
+	}
+	if err := d.Set("name", res[2]); err != nil {
+		return err
+	}
+
+	// The key material, barcode and generation options are never returned by Vault.
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on TOTP key %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting TOTP key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted TOTP key %q", path)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTOTPSecretBackendKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	generated := "vault_totp_secret_backend_key.generated"
+	imported := "vault_totp_secret_backend_key.imported"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testTOTPSecretBackendKey_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(generated, "backend", backend),
+					resource.TestCheckResourceAttr(generated, "name", "generated"),
+					resource.TestCheckResourceAttr(generated, "issuer", "Vault"),
+					resource.TestCheckResourceAttr(generated, "account_name", "svc@example.com"),
+					resource.TestCheckResourceAttr(generated, "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(generated, "digits", "8"),
+					resource.TestCheckResourceAttr(generated, "period", "60"),
+					resource.TestCheckResourceAttrSet(generated, "barcode"),
+					resource.TestMatchResourceAttr(generated, "url", regexp.MustCompile("^otpauth://totp/")),
+					resource.TestCheckResourceAttr(imported, "issuer", "Example"),
+					resource.TestCheckResourceAttr(imported, "account_name", "alice"),
+					resource.TestCheckResourceAttr(imported, "digits", "6"),
+					resource.TestCheckResourceAttr(imported, "barcode", ""),
+				),
+			},
+			{
+				ResourceName:      imported,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"generate", "exported", "key_size", "url", "key", "skew", "qr_size",
+				},
+			},
+		},
+	})
+}
+
+func testTOTPSecretBackendKey_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "generated" {
+  backend      = vault_mount.totp.path
+  name         = "generated"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "svc@example.com"
+  algorithm    = "SHA256"
+  digits       = 8
+  period       = 60
+}
+
+resource "vault_totp_secret_backend_key" "imported" {
+  backend = vault_mount.totp.path
+  name    = "imported"
+  url     = "otpauth://totp/Example:alice?secret=JBSWY3DPEHPK3PXP&issuer=Example"
+}
+`, backend)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+const transitKeyTypeManagedKey = "managed_key"
+
 var (
 	transitSecretBackendKeyBackendFromPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
 	transitSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+)$")
@@ -92,10 +94,24 @@ func transitSecretBackendKeyResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "Specifies the type of key to create. The currently-supported types are: aes128-gcm96, aes256-gcm96, chacha20-poly1305, ed25519, ecdsa-p256, ecdsa-p384, ecdsa-p521, rsa-2048, rsa-3072, rsa-4096",
+				Description:  "Specifies the type of key to create. The currently-supported types are: aes128-gcm96, aes256-gcm96, chacha20-poly1305, ed25519, ecdsa-p256, ecdsa-p384, ecdsa-p521, rsa-2048, rsa-3072, rsa-4096, aes128-cmac, aes192-cmac, aes256-cmac, managed_key",
 				ForceNew:     true,
 				Default:      "aes256-gcm96",
-				ValidateFunc: validation.StringInSlice([]string{"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "rsa-2048", "rsa-3072", "rsa-4096"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "rsa-2048", "rsa-3072", "rsa-4096", "aes128-cmac", "aes192-cmac", "aes256-cmac", transitKeyTypeManagedKey}, false),
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The name of the managed key to use when the key type is managed_key.",
+				ConflictsWith: []string{"managed_key_id"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The UUID of the managed key to use when the key type is managed_key.",
+				ConflictsWith: []string{"managed_key_name"},
 			},
 			"keys": {
 				Type:        schema.TypeList,
@@ -187,10 +203,34 @@ func transitSecretBackendKeyResource() *schema.Resource {
 			customdiff.ForceNewIfChange("allow_plaintext_backup", func(_ context.Context, old, new, meta interface{}) bool {
 				return !new.(bool) && old.(bool)
 			}),
+			transitSecretBackendKeyValidateManagedKey,
 		),
 	}
 }
 
+// transitSecretBackendKeyValidateManagedKey ensures that one of
+// managed_key_name or managed_key_id is set if, and only if, type is
+// "managed_key".
+func transitSecretBackendKeyValidateManagedKey(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, k := range []string{"type", "managed_key_name", "managed_key_id"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	managedKeyName := d.Get("managed_key_name").(string)
+	managedKeyID := d.Get("managed_key_id").(string)
+	if d.Get("type").(string) == transitKeyTypeManagedKey {
+		if managedKeyName == "" && managedKeyID == "" {
+			return fmt.Errorf("one of managed_key_name or managed_key_id is required when type is %q", transitKeyTypeManagedKey)
+		}
+	} else if managedKeyName != "" || managedKeyID != "" {
+		return fmt.Errorf("managed_key_name and managed_key_id can only be set when type is %q", transitKeyTypeManagedKey)
+	}
+
+	return nil
+}
+
 func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -199,6 +239,10 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	path := transitSecretBackendKeyPath(backend, name)
 
+	keyType := d.Get("type").(string)
+	managedKeyName := d.Get("managed_key_name").(string)
+	managedKeyID := d.Get("managed_key_id").(string)
+
 	autoRotatePeriod := getTransitAutoRotatePeriod(d)
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
@@ -212,10 +256,17 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 	data := map[string]interface{}{
 		"convergent_encryption": d.Get("convergent_encryption").(bool),
 		"derived":               d.Get("derived").(bool),
-		"type":                  d.Get("type").(string),
+		"type":                  keyType,
 		"auto_rotate_period":    autoRotatePeriod,
 	}
 
+	if managedKeyName != "" {
+		data["managed_key_name"] = managedKeyName
+	}
+	if managedKeyID != "" {
+		data["managed_key_id"] = managedKeyID
+	}
+
 	log.Printf("[DEBUG] Creating encryption key %s on transit secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -149,6 +149,33 @@ func TestTransitSecretBackendKey_rsa4096(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_managedKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTransitSecretBackendKeyConfig_managedKey(name, backend, "aes256-gcm96", `managed_key_name = "hsm-key"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`managed_key_name and managed_key_id can only be set when type is "managed_key"`),
+			},
+			{
+				Config:      testTransitSecretBackendKeyConfig_managedKey(name, backend, "managed_key", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`one of managed_key_name or managed_key_id is required when type is "managed_key"`),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_managedKey(name, backend, "managed_key",
+					"managed_key_name = \"hsm-key\"\n  managed_key_id = \"ec4ca3c6-6a31-4fd1-8a52-ca4ecc2a0cde\""),
+				ExpectError: regexp.MustCompile("Error: Conflicting configuration arguments"),
+			},
+		},
+	})
+}
+
 func testTransitSecretBackendKeyConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -262,6 +289,22 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_managedKey(name, path, keyType, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend = vault_mount.transit.path
+  name    = "%s"
+  type    = "%s"
+  %s
+}
+`, path, name, keyType, extra)
+}
+
 func testTransitSecretBackendKeyCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var userpassAuthBackendUserFromPathRegex = regexp.MustCompile("^auth/(.+)/users/([^/]+)$")
+
+func userpassAuthBackendUserResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"username": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the user.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Password of the user. Changing it updates the password without recreating the user.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "userpass",
+			Description: "Auth backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: userpassAuthBackendUserCreate,
+		Update: userpassAuthBackendUserUpdate,
+		Read:   userpassAuthBackendUserRead,
+		Delete: userpassAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func userpassAuthBackendUserPath(backend, username string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/users/" + strings.Trim(username, "/")
+}
+
+func userpassAuthBackendUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	username := d.Get("username").(string)
+
+	path := userpassAuthBackendUserPath(backend, username)
+
+	data := map[string]interface{}{
+		"password": d.Get("password").(string),
+	}
+	updateTokenFields(d, data, true)
+
+	log.Printf("[DEBUG] Writing userpass user %q", path)
+	d.SetId(path)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("error writing userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote userpass user %q", path)
+
+	return userpassAuthBackendUserRead(d, meta)
+}
+
+func userpassAuthBackendUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	// The password has a dedicated endpoint, so that rotating it leaves
+	// the rest of the user untouched.
+	if d.HasChange("password") {
+		log.Printf("[DEBUG] Updating password of userpass user %q", path)
+		_, err := client.Logical().Write(path+"/password", map[string]interface{}{
+			"password": d.Get("password").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating password of userpass user %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated password of userpass user %q", path)
+	}
+
+	data := map[string]interface{}{}
+	updateTokenFields(d, data, false)
+
+	if len(data) > 0 {
+		log.Printf("[DEBUG] Updating userpass user %q", path)
+		_, err := client.Logical().Write(path, data)
+		if err != nil {
+			return fmt.Errorf("error updating userpass user %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated userpass user %q", path)
+	}
+
+	return userpassAuthBackendUserRead(d, meta)
+}
+
+func userpassAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, username, err := userpassAuthBackendUserFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading userpass user %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read userpass user %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Userpass user %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", backend); err != nil {
+		return err
+	}
+	if err := d.Set("username", username); err != nil {
+		return err
+	}
+
+	// The password is never returned by Vault.
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func userpassAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting userpass user %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted userpass user %q", path)
+
+	return nil
+}
+
+func userpassAuthBackendUserFromPath(path string) (string, string, error) {
+	res := userpassAuthBackendUserFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("invalid path %q for userpass user, expected auth/<backend>/users/<username>", path)
+	}
+	return res[1], res[2], nil
+}
@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestUserpassAuthBackendUser_pathRegex(t *testing.T) {
+	tests := map[string]struct {
+		path     string
+		backend  string
+		username string
+		wantErr  bool
+	}{
+		"simple":          {path: "auth/userpass/users/bob", backend: "userpass", username: "bob"},
+		"nested":          {path: "auth/team/userpass/users/bob", backend: "team/userpass", username: "bob"},
+		"missing user":    {path: "auth/userpass/users/", wantErr: true},
+		"not a user path": {path: "auth/userpass/config", wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			backend, username, err := userpassAuthBackendUserFromPath(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for path %q", tt.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if backend != tt.backend || username != tt.username {
+				t.Fatalf("expected %q, %q, got %q, %q", tt.backend, tt.username, backend, username)
+			}
+		})
+	}
+}
+
+func TestUserpassAuthBackendUser(t *testing.T) {
+	t.Run("simple backend path", func(t *testing.T) {
+		backend := acctest.RandomWithPrefix("tf-test-userpass")
+		testUserpassAuthBackendUser(t, backend)
+	})
+	t.Run("nested backend path", func(t *testing.T) {
+		backend := acctest.RandomWithPrefix("tf-test-userpass") + "/nested"
+		testUserpassAuthBackendUser(t, backend)
+	})
+}
+
+func testUserpassAuthBackendUser(t *testing.T, backend string) {
+	username := acctest.RandomWithPrefix("user")
+	resourceName := "vault_userpass_auth_backend_user.test"
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testUserpassAuthBackendUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUserpassAuthBackendUserConfig(backend, username, "s3cr3t-1", "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "600"),
+					testUserpassAuthBackendUserLogin(resourceName, "s3cr3t-1"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testUserpassAuthBackendUserConfig(backend, username, "s3cr3t-2", "ops"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_policies.*", "ops"),
+					testUserpassAuthBackendUserLogin(resourceName, "s3cr3t-2"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[resourceName].Primary.ID; got != id {
+							return fmt.Errorf("expected the user to be updated in place, ID changed from %q to %q", id, got)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testUserpassAuthBackendUserLogin(resourceName, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		path := fmt.Sprintf("auth/%s/login/%s", rs.Primary.Attributes["backend"], rs.Primary.Attributes["username"])
+		resp, err := client.Logical().Write(path, map[string]interface{}{
+			"password": password,
+		})
+		if err != nil {
+			return fmt.Errorf("error logging in as %q: %s", rs.Primary.Attributes["username"], err)
+		}
+		if resp == nil || resp.Auth == nil {
+			return fmt.Errorf("expected auth in login response for %q", rs.Primary.Attributes["username"])
+		}
+		return nil
+	}
+}
+
+func testUserpassAuthBackendUserDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_userpass_auth_backend_user" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for userpass user %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("userpass user %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testUserpassAuthBackendUserConfig(backend, username, password, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  path = "%s"
+  type = "userpass"
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  backend        = vault_auth_backend.userpass.path
+  username       = "%s"
+  password       = "%s"
+  token_policies = ["%s"]
+  token_ttl      = 600
+}
+`, backend, username, password, policy)
+}